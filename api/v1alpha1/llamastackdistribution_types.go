@@ -21,6 +21,8 @@ package v1alpha1
 
 //nolint:gci
 import (
+	"time"
+
 	corev1 "k8s.io/api/core/v1"
 	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
 	"k8s.io/apimachinery/pkg/api/resource"
@@ -35,14 +37,29 @@ const (
 	DefaultServerPort int32 = 8321
 	// DefaultServicePortName is the default name for the service port
 	DefaultServicePortName = "http"
+	// DefaultContainerPortName is the name of the server container port targeted by the service and probes
+	DefaultContainerPortName = "http"
 	// DefaultLabelKey is the default key for labels
 	DefaultLabelKey = "app"
 	// DefaultLabelValue is the default value for labels
 	DefaultLabelValue = "llama-stack"
 	// DefaultMountPath is the default mount path for storage
 	DefaultMountPath = "/.llama"
+	// DefaultGPUResourceName is the extended resource used for GPU requests
+	DefaultGPUResourceName = "nvidia.com/gpu"
 	// LlamaStackDistributionKind is the kind name for LlamaStackDistribution resources
 	LlamaStackDistributionKind = "LlamaStackDistribution"
+	// RollAnnotationKey is the LlamaStackDistribution annotation whose value is copied to the
+	// pod template, so changing it rolls the server pods without a config change
+	RollAnnotationKey = "llamastack.io/roll"
+	// DefaultDistributionName is the distribution used when neither name nor image is set
+	DefaultDistributionName = "starter"
+	// DefaultedDistributionAnnotationKey records the distribution the operator filled in
+	// because the spec left both name and image empty
+	DefaultedDistributionAnnotationKey = "llamastack.io/defaulted-distribution"
+	// PromoteCanaryAnnotationKey, when set on a LlamaStackDistribution, makes the operator
+	// copy the canary userConfig onto server.userConfig and remove the canary
+	PromoteCanaryAnnotationKey = "llamastack.io/promote-canary"
 )
 
 var (
@@ -51,6 +68,8 @@ var (
 	// Default requests ensure the HPA and scheduler have baseline values
 	DefaultServerCPURequest    = resource.MustParse("500m")
 	DefaultServerMemoryRequest = resource.MustParse("1Gi")
+	// DefaultFailureGracePeriod is how long the deployment may be unavailable before the distribution is marked Failed
+	DefaultFailureGracePeriod = metav1.Duration{Duration: 2 * time.Minute}
 )
 
 // DistributionType defines the distribution configuration for llama-stack.
@@ -78,6 +97,68 @@ type LlamaStackDistributionSpec struct {
 	// Network defines network access controls for the LlamaStack service
 	// +optional
 	Network *NetworkSpec `json:"network,omitempty"`
+	// FailureGracePeriod is how long the deployment may have no ready replicas
+	// before the distribution is marked Failed. Defaults to 2m.
+	// +optional
+	FailureGracePeriod *metav1.Duration `json:"failureGracePeriod,omitempty"`
+	// Rollout configures progressive rollout of configuration changes
+	// +optional
+	Rollout *RolloutSpec `json:"rollout,omitempty"`
+	// Monitoring configures Prometheus scraping of the server
+	// +optional
+	Monitoring *MonitoringSpec `json:"monitoring,omitempty"`
+}
+
+// MonitoringSpec defines Prometheus integration for the server.
+type MonitoringSpec struct {
+	// ServiceMonitor creates a Prometheus Operator ServiceMonitor for the server Service.
+	// It is skipped when the monitoring.coreos.com CRDs are not installed.
+	// +optional
+	ServiceMonitor *ServiceMonitorSpec `json:"serviceMonitor,omitempty"`
+}
+
+// ServiceMonitorSpec defines the scrape endpoint of the ServiceMonitor.
+type ServiceMonitorSpec struct {
+	// Enabled toggles creation of the ServiceMonitor
+	// +optional
+	Enabled bool `json:"enabled,omitempty"`
+	// Interval is how often Prometheus scrapes the server
+	// +optional
+	// +kubebuilder:default:="30s"
+	// +kubebuilder:validation:Pattern=`^([0-9]+(ms|s|m|h))+$`
+	Interval string `json:"interval,omitempty"`
+	// Path is the HTTP path that serves the metrics
+	// +optional
+	// +kubebuilder:default:="/metrics"
+	// +kubebuilder:validation:Pattern=`^/`
+	Path string `json:"path,omitempty"`
+}
+
+// RolloutSpec defines progressive rollout settings for the server.
+type RolloutSpec struct {
+	// Canary runs a second Deployment with a candidate config next to the primary one
+	// +optional
+	Canary *CanarySpec `json:"canary,omitempty"`
+}
+
+// CanarySpec defines a canary Deployment that runs a candidate config behind the
+// primary Service. The Service balances across the ready pods of both Deployments,
+// so the traffic split follows the pod counts. To promote the canary, annotate the
+// LlamaStackDistribution with llamastack.io/promote-canary.
+type CanarySpec struct {
+	// Replicas is the number of canary pods. Ignored when weight is set.
+	// +kubebuilder:default:=1
+	// +kubebuilder:validation:Minimum=1
+	Replicas int32 `json:"replicas,omitempty"`
+	// Weight is the percentage of Service traffic to send to the canary. The operator
+	// sizes the canary so it holds this share of the pods behind the Service, rounding
+	// up to at least one pod, so the split is approximate for small primaries.
+	// +optional
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=99
+	Weight *int32 `json:"weight,omitempty"`
+	// UserConfig is the candidate configuration run by the canary pods
+	UserConfig UserConfigSpec `json:"userConfig"`
 }
 
 // NetworkSpec defines network access controls for the LlamaStack service.
@@ -92,9 +173,58 @@ type NetworkSpec struct {
 	// By default, only the LLSD namespace and the operator namespace are allowed.
 	// +optional
 	AllowedFrom *AllowedFromSpec `json:"allowedFrom,omitempty"`
+
+	// SessionAffinity configures client session affinity on the LlamaStack service.
+	// Default is None.
+	// +optional
+	SessionAffinity *SessionAffinitySpec `json:"sessionAffinity,omitempty"`
+
+	// BindAddress is the IP address the server listens on. Set it to 127.0.0.1 to
+	// expose the server only through a service mesh sidecar. Default is 0.0.0.0.
+	// Requires the operator to start the server, i.e. userConfig set and no custom
+	// containerSpec.command; the instance fails to reconcile otherwise.
+	// +optional
+	BindAddress string `json:"bindAddress,omitempty"`
+
+	// DrainTimeoutSeconds bounds the preStop hook of a terminating pod: it POSTs to the
+	// server's drain endpoint so no new requests are accepted, then waits out the rest of
+	// the timeout while the pod is removed from the Service endpoints. The termination grace
+	// period is sized to the drain timeout plus the default 30 seconds for in-flight requests
+	// to finish. An explicit containerSpec.lifecycle.preStop or
+	// podOverrides.terminationGracePeriodSeconds takes precedence.
+	// +optional
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=3600
+	DrainTimeoutSeconds *int32 `json:"drainTimeoutSeconds,omitempty"`
+
+	// DrainPath is the HTTP path of the server's drain endpoint called by the preStop hook
+	// when drainTimeoutSeconds is set. Default is /v1/drain.
+	// +optional
+	// +kubebuilder:validation:Pattern=`^/`
+	DrainPath string `json:"drainPath,omitempty"`
+
+	// PublishNotReadyAddresses makes the Service include pods that are not ready yet, for
+	// clients that discover peers during startup. Default is false.
+	// +optional
+	PublishNotReadyAddresses bool `json:"publishNotReadyAddresses,omitempty"`
 }
 
-// AllowedFromSpec defines namespace-based access controls for NetworkPolicies.
+// SessionAffinitySpec defines session affinity for the LlamaStack service.
+// +kubebuilder:validation:XValidation:rule="self.type == 'ClientIP' || !has(self.timeoutSeconds)",message="timeoutSeconds can only be set for ClientIP session affinity"
+type SessionAffinitySpec struct {
+	// Type is the session affinity type.
+	// +kubebuilder:validation:Enum=None;ClientIP
+	Type string `json:"type"`
+
+	// TimeoutSeconds is how long a ClientIP session sticks to the same pod.
+	// Kubernetes defaults to 10800 (3 hours) when unset.
+	// +optional
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=86400
+	TimeoutSeconds *int32 `json:"timeoutSeconds,omitempty"`
+}
+
+// AllowedFromSpec defines namespace- and pod-based access controls for NetworkPolicies.
 type AllowedFromSpec struct {
 	// Namespaces is an explicit list of namespace names allowed to access the service.
 	// Use "*" to allow all namespaces.
@@ -106,11 +236,23 @@ type AllowedFromSpec struct {
 	// Example: ["myproject/lls-allowed", "team/authorized"]
 	// +optional
 	Labels []string `json:"labels,omitempty"`
+
+	// PodSelector allows pods matching this selector in any namespace to access the service.
+	// Combined with the namespace rules using OR semantics.
+	// Example: {"matchLabels": {"role": "gateway"}}
+	// +optional
+	PodSelector *metav1.LabelSelector `json:"podSelector,omitempty"`
 }
 
 // ServerSpec defines the desired state of llama server.
+// +kubebuilder:validation:XValidation:rule="!has(self.storage) || !has(self.storage.disabled) || !self.storage.disabled || has(self.userConfig)",message="storage.disabled requires userConfig with a non-sqlite metadata store"
 type ServerSpec struct {
-	Distribution  DistributionType `json:"distribution"`
+	// Distribution selects the llama-stack image by name or by direct reference.
+	// Defaults to the starter distribution when omitted. When the operator fills in the
+	// default itself it records it in the llamastack.io/defaulted-distribution annotation.
+	// +optional
+	// +kubebuilder:default={name: "starter"}
+	Distribution  DistributionType `json:"distribution,omitzero"`
 	ContainerSpec ContainerSpec    `json:"containerSpec,omitempty"`
 	// Workers configures the number of uvicorn worker processes to run.
 	// When set, the operator will launch llama-stack using uvicorn with the specified worker count.
@@ -138,12 +280,61 @@ type ServerSpec struct {
 	// TLSConfig defines the TLS configuration for the llama-stack server
 	// +optional
 	TLSConfig *TLSConfig `json:"tlsConfig,omitempty"`
+	// LogLevel sets the log level of the llama-stack server and uvicorn. The uvicorn
+	// log level only applies when the operator starts the server, i.e. when userConfig
+	// is set, and the image runs llama-stack 0.3.0 or later.
+	// +optional
+	// +kubebuilder:validation:Enum=debug;info;warning;error
+	LogLevel string `json:"logLevel,omitempty"`
+	// AccessLog enables or disables the uvicorn access log (enabled by default). It only
+	// applies when the operator starts the server, i.e. when userConfig is set, and the
+	// image runs llama-stack 0.3.0 or later.
+	// +optional
+	AccessLog *bool `json:"accessLog,omitempty"`
+	// UpdateStrategy controls how the server Deployment rolls out changes.
+	// Defaults to RollingUpdate, or Recreate when storage is configured.
+	// +optional
+	UpdateStrategy *UpdateStrategySpec `json:"updateStrategy,omitempty"`
+	// ConfigMountPath is the absolute file path where the user config is mounted and
+	// from which the server loads it. Defaults to /etc/llama-stack/config.yaml.
+	// +optional
+	// +kubebuilder:validation:Pattern=`^/.*[^/]$`
+	ConfigMountPath string `json:"configMountPath,omitempty"`
+	// HealthPath is the HTTP path used by the server health probe.
+	// Defaults to the path registered for the selected distribution in the
+	// llama-stack-distributions ConfigMap, or /v1/health.
+	// +optional
+	// +kubebuilder:validation:Pattern=`^/`
+	HealthPath string `json:"healthPath,omitempty"`
+	// StartupProbe tunes how long the kubelet waits for the server to finish loading
+	// before the pod is restarted, e.g. for large models
+	// +optional
+	StartupProbe *StartupProbeSpec `json:"startupProbe,omitempty"`
+	// Proxy configures the egress HTTP proxy used by the server to reach providers
+	// +optional
+	Proxy *ProxySpec `json:"proxy,omitempty"`
+}
+
+// ProxySpec defines the egress HTTP proxy settings injected into the server container.
+// Env vars with the same name in containerSpec.env take precedence.
+type ProxySpec struct {
+	// HTTPProxy is exposed as HTTP_PROXY
+	// +optional
+	HTTPProxy string `json:"httpProxy,omitempty"`
+	// HTTPSProxy is exposed as HTTPS_PROXY
+	// +optional
+	HTTPSProxy string `json:"httpsProxy,omitempty"`
+	// NoProxy is exposed as NO_PROXY, a comma-separated list of hosts that bypass the proxy
+	// +optional
+	NoProxy string `json:"noProxy,omitempty"`
 }
 
 type UserConfigSpec struct {
 	// ConfigMapName is the name of the ConfigMap containing user configuration
 	ConfigMapName string `json:"configMapName"`
-	// ConfigMapNamespace is the namespace of the ConfigMap (defaults to the same namespace as the CR)
+	// ConfigMapNamespace is the namespace of the ConfigMap (defaults to the same namespace as the CR).
+	// Other namespaces must be listed in the operator's allowed-configmap-namespaces; the
+	// ConfigMap is then copied into the CR namespace.
 	// +optional
 	ConfigMapNamespace string `json:"configMapNamespace,omitempty"`
 }
@@ -153,6 +344,12 @@ type TLSConfig struct {
 	// CABundle defines the CA bundle configuration for custom certificates
 	// +optional
 	CABundle *CABundleConfig `json:"caBundle,omitempty"`
+	// AdditionalCABundles references further CA bundle ConfigMaps, e.g. separate internal
+	// and partner bundles. Their certificates are concatenated with caBundle into the
+	// mounted trust store.
+	// +optional
+	// +kubebuilder:validation:MaxItems=10
+	AdditionalCABundles []CABundleConfig `json:"additionalCABundles,omitempty"`
 }
 
 // CABundleConfig defines the CA bundle configuration for custom certificates
@@ -172,12 +369,43 @@ type CABundleConfig struct {
 	ConfigMapKeys []string `json:"configMapKeys,omitempty"`
 }
 
+// StartupProbeSpec defines the timing of the server startup probe. The server may take up
+// to failureThreshold * periodSeconds to become healthy.
+type StartupProbeSpec struct {
+	// FailureThreshold is the number of failed probes tolerated before the container is restarted.
+	// Defaults to 30
+	// +optional
+	// +kubebuilder:validation:Minimum=1
+	FailureThreshold *int32 `json:"failureThreshold,omitempty"`
+	// PeriodSeconds is how often the probe runs. Defaults to 10
+	// +optional
+	// +kubebuilder:validation:Minimum=1
+	PeriodSeconds *int32 `json:"periodSeconds,omitempty"`
+}
+
 // StorageSpec defines the persistent storage configuration
+// +kubebuilder:validation:XValidation:rule="!has(self.disabled) || !self.disabled || (!has(self.size) && !has(self.mountPath))",message="size and mountPath cannot be set when storage is disabled"
+// +kubebuilder:validation:XValidation:rule="!has(self.existingClaimName) || (!has(self.size) && (!has(self.disabled) || !self.disabled))",message="existingClaimName cannot be combined with size or disabled"
 type StorageSpec struct {
 	// Size is the size of the persistent volume claim created for holding persistent data of the llama-stack server
 	Size *resource.Quantity `json:"size,omitempty"`
 	// MountPath is the path where the storage will be mounted in the container
 	MountPath string `json:"mountPath,omitempty"`
+	// Disabled removes the storage volume and its mount entirely, for servers that keep
+	// all state in external backends. The userConfig must then configure a non-sqlite
+	// metadata store.
+	// +optional
+	Disabled bool `json:"disabled,omitempty"`
+	// ExistingClaimName mounts a pre-created PVC instead of having the operator create one.
+	// The claim must exist in the same namespace.
+	// +optional
+	// +kubebuilder:validation:MinLength=1
+	ExistingClaimName string `json:"existingClaimName,omitempty"`
+	// RetainOnDelete keeps the operator-created PVC when the LlamaStackDistribution is
+	// deleted by leaving it without an owner reference. Defaults to false, in which case
+	// the PVC is garbage collected with the instance.
+	// +optional
+	RetainOnDelete bool `json:"retainOnDelete,omitempty"`
 }
 
 // ContainerSpec defines the llama-stack server container configuration.
@@ -189,6 +417,47 @@ type ContainerSpec struct {
 	Env       []corev1.EnvVar             `json:"env,omitempty"` // Runtime env vars (e.g., INFERENCE_MODEL)
 	Command   []string                    `json:"command,omitempty"`
 	Args      []string                    `json:"args,omitempty"`
+	// ExtraArgs are appended to the default container args, e.g. a single extra
+	// server flag. Ignored when args is set, since a full override takes precedence.
+	// Requires userConfig or command, since the image's default arguments are not known.
+	// +optional
+	ExtraArgs []string `json:"extraArgs,omitempty"`
+	// Lifecycle defines actions the kubelet runs on container start and before termination,
+	// e.g. a preStop hook that drains in-flight requests
+	// +optional
+	Lifecycle *corev1.Lifecycle `json:"lifecycle,omitempty"`
+	// WorkingDir overrides the working directory of the container image
+	// +optional
+	WorkingDir string `json:"workingDir,omitempty"`
+	// RunAsUser sets the UID the container process runs as, for images that
+	// expect a specific non-root user
+	// +optional
+	// +kubebuilder:validation:Minimum=0
+	RunAsUser *int64 `json:"runAsUser,omitempty"`
+	// GPU requests accelerators for the container. An explicit entry for the same
+	// resource in resources takes precedence.
+	// +optional
+	GPU *GPUSpec `json:"gpu,omitempty"`
+	// InjectDownwardEnv exposes POD_NAME, POD_NAMESPACE, POD_IP and NODE_NAME to the
+	// container through the downward API. Env vars with the same name in env take precedence.
+	// +optional
+	InjectDownwardEnv bool `json:"injectDownwardEnv,omitempty"`
+	// AutoTuneThreads sets OMP_NUM_THREADS and GOMAXPROCS from the container CPU limit,
+	// rounded up to whole cores. Has no effect without a CPU limit. Env vars with the same
+	// name in env take precedence.
+	// +optional
+	AutoTuneThreads bool `json:"autoTuneThreads,omitempty"`
+}
+
+// GPUSpec requests a number of GPUs through an extended resource.
+type GPUSpec struct {
+	// Count is the number of GPUs requested
+	// +kubebuilder:validation:Minimum=1
+	Count int32 `json:"count"`
+	// ResourceName is the extended resource advertised by the device plugin
+	// +optional
+	// +kubebuilder:default:="nvidia.com/gpu"
+	ResourceName string `json:"resourceName,omitempty"`
 }
 
 // PodOverrides allows advanced pod-level customization.
@@ -200,9 +469,18 @@ type PodOverrides struct {
 	// TerminationGracePeriodSeconds is the time allowed for graceful pod shutdown.
 	// If not specified, Kubernetes defaults to 30 seconds.
 	// +optional
-	TerminationGracePeriodSeconds *int64               `json:"terminationGracePeriodSeconds,omitempty"`
-	Volumes                       []corev1.Volume      `json:"volumes,omitempty"`
-	VolumeMounts                  []corev1.VolumeMount `json:"volumeMounts,omitempty"`
+	TerminationGracePeriodSeconds *int64 `json:"terminationGracePeriodSeconds,omitempty"`
+	// FSGroup is the supplemental group applied to mounted volumes so they are
+	// group-writable by all server workers. Defaults to 1001.
+	// +optional
+	// +kubebuilder:validation:Minimum=0
+	FSGroup      *int64               `json:"fsGroup,omitempty"`
+	Volumes      []corev1.Volume      `json:"volumes,omitempty"`
+	VolumeMounts []corev1.VolumeMount `json:"volumeMounts,omitempty"`
+	// Affinity sets the pod scheduling affinity. When set, it replaces the default
+	// pod anti-affinity applied when running more than one replica.
+	// +optional
+	Affinity *corev1.Affinity `json:"affinity,omitempty"`
 }
 
 // PodDisruptionBudgetSpec defines voluntary disruption controls.
@@ -215,6 +493,20 @@ type PodDisruptionBudgetSpec struct {
 	MaxUnavailable *intstr.IntOrString `json:"maxUnavailable,omitempty"`
 }
 
+// UpdateStrategySpec defines the rollout strategy of the server Deployment.
+// +kubebuilder:validation:XValidation:rule="self.type == 'RollingUpdate' || (!has(self.maxSurge) && !has(self.maxUnavailable))",message="maxSurge and maxUnavailable can only be set for the RollingUpdate strategy"
+type UpdateStrategySpec struct {
+	// Type is the Deployment strategy type
+	// +kubebuilder:validation:Enum=RollingUpdate;Recreate
+	Type string `json:"type"`
+	// MaxSurge is the maximum number of pods that can be created above the desired replica count
+	// +optional
+	MaxSurge *intstr.IntOrString `json:"maxSurge,omitempty"`
+	// MaxUnavailable is the maximum number of pods that can be unavailable during the update
+	// +optional
+	MaxUnavailable *intstr.IntOrString `json:"maxUnavailable,omitempty"`
+}
+
 // AutoscalingSpec configures HorizontalPodAutoscaler targets.
 type AutoscalingSpec struct {
 	// MinReplicas is the lower bound replica count maintained by the HPA
@@ -248,6 +540,12 @@ type DistributionConfig struct {
 	AvailableDistributions map[string]string `json:"availableDistributions,omitempty"`
 }
 
+// ResolvedDistributionStatus describes the distribution image the server runs.
+type ResolvedDistributionStatus struct {
+	// Image is the container image resolved from the distribution name or image
+	Image string `json:"image,omitempty"`
+}
+
 // LlamaStackDistributionPhase represents the current phase of the LlamaStackDistribution
 // +kubebuilder:validation:Enum=Pending;Initializing;Ready;Failed;Terminating
 type DistributionPhase string
@@ -293,6 +591,29 @@ type LlamaStackDistributionStatus struct {
 	// nil when external access is not configured, empty string when Ingress exists but URL not ready.
 	// +optional
 	RouteURL *string `json:"routeURL,omitempty"`
+	// ResolvedDistribution records the distribution image most recently rolled out
+	// +optional
+	ResolvedDistribution ResolvedDistributionStatus `json:"resolvedDistribution,omitempty"`
+	// UnavailableSince is the time the deployment was first observed without ready replicas.
+	// Cleared once a replica becomes ready.
+	// +optional
+	UnavailableSince *metav1.Time `json:"unavailableSince,omitempty"`
+	// Workload reports how the server workload is actually configured.
+	// Unset until the Deployment has been read.
+	// +optional
+	Workload *WorkloadStatus `json:"workload,omitempty"`
+}
+
+// WorkloadStatus reports settings resolved by the operator for the running server.
+type WorkloadStatus struct {
+	// EffectiveWorkers is the uvicorn worker count injected into the server container,
+	// after defaulting and any env override in containerSpec.env
+	// +optional
+	EffectiveWorkers int32 `json:"effectiveWorkers,omitempty"`
+	// InjectedEnvVars lists the names of the env vars the operator added to the server
+	// container, excluding those from containerSpec.env. Values are never reported.
+	// +optional
+	InjectedEnvVars []string `json:"injectedEnvVars,omitempty"`
 }
 
 //+kubebuilder:object:root=true
@@ -330,6 +651,29 @@ func init() { //nolint:gochecknoinits
 	SchemeBuilder.Register(&LlamaStackDistribution{}, &LlamaStackDistributionList{})
 }
 
+// HasPersistentStorage checks if the server uses a PVC for its storage volume.
+func (r *LlamaStackDistribution) HasPersistentStorage() bool {
+	return r.Spec.Server.Storage != nil && !r.Spec.Server.Storage.Disabled
+}
+
+// ManagesPVC checks if the operator creates the PVC backing the storage volume.
+func (r *LlamaStackDistribution) ManagesPVC() bool {
+	return r.HasPersistentStorage() && r.Spec.Server.Storage.ExistingClaimName == ""
+}
+
+// PVCName returns the name of the PVC backing the storage volume.
+func (r *LlamaStackDistribution) PVCName() string {
+	if r.Spec.Server.Storage != nil && r.Spec.Server.Storage.ExistingClaimName != "" {
+		return r.Spec.Server.Storage.ExistingClaimName
+	}
+	return r.Name + "-pvc"
+}
+
+// IsStorageDisabled checks if the storage volume has been turned off.
+func (r *LlamaStackDistribution) IsStorageDisabled() bool {
+	return r.Spec.Server.Storage != nil && r.Spec.Server.Storage.Disabled
+}
+
 // HasPorts checks if the container spec defines a port.
 func (r *LlamaStackDistribution) HasPorts() bool {
 	return r.Spec.Server.ContainerSpec.Port != 0 || len(r.Spec.Server.ContainerSpec.Env) > 0
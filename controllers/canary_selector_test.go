@@ -0,0 +1,239 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controllers
+
+import (
+	"testing"
+
+	llamav1alpha1 "github.com/llamastack/llama-stack-k8s-operator/api/v1alpha1"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	networkingv1 "k8s.io/api/networking/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta/testrestmapper"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+func newCanaryTestReconciler(t *testing.T, instance *llamav1alpha1.LlamaStackDistribution) *LlamaStackDistributionReconciler {
+	t.Helper()
+	scheme := runtime.NewScheme()
+	require.NoError(t, clientgoscheme.AddToScheme(scheme))
+	require.NoError(t, llamav1alpha1.AddToScheme(scheme))
+
+	configMaps := []client.Object{instance}
+	for _, name := range []string{"primary-config", "canary-config"} {
+		configMaps = append(configMaps, &corev1.ConfigMap{
+			ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: instance.Namespace},
+			Data:       map[string]string{"config.yaml": "version: 2\n"},
+		})
+	}
+
+	return &LlamaStackDistributionReconciler{
+		Client: fake.NewClientBuilder().WithScheme(scheme).
+			WithRESTMapper(testrestmapper.TestOnlyStaticRESTMapper(scheme)).
+			WithObjects(configMaps...).Build(),
+		Scheme:              scheme,
+		EnableNetworkPolicy: true,
+		ClusterInfo:         setupTestClusterInfo(map[string]string{"starter": "docker.io/llamastack/distribution-starter:latest"}),
+	}
+}
+
+func newCanaryTestInstance(withCanary bool) *llamav1alpha1.LlamaStackDistribution {
+	instance := &llamav1alpha1.LlamaStackDistribution{
+		ObjectMeta: metav1.ObjectMeta{Name: "canary", Namespace: "default", UID: "canary-uid"},
+		Spec: llamav1alpha1.LlamaStackDistributionSpec{
+			Server: llamav1alpha1.ServerSpec{
+				Distribution:  llamav1alpha1.DistributionType{Name: "starter"},
+				ContainerSpec: llamav1alpha1.ContainerSpec{Port: llamav1alpha1.DefaultServerPort},
+				UserConfig:    &llamav1alpha1.UserConfigSpec{ConfigMapName: "primary-config"},
+			},
+		},
+	}
+	if withCanary {
+		instance.Spec.Rollout = &llamav1alpha1.RolloutSpec{
+			Canary: &llamav1alpha1.CanarySpec{
+				Replicas:   1,
+				UserConfig: llamav1alpha1.UserConfigSpec{ConfigMapName: "canary-config"},
+			},
+		}
+	}
+	return instance
+}
+
+// assertCanaryShareable checks that the primary workload leaves the canary pods alone
+// while the Service and NetworkPolicy cover both.
+func assertCanaryShareable(t *testing.T, r *LlamaStackDistributionReconciler, instance *llamav1alpha1.LlamaStackDistribution) {
+	t.Helper()
+	ctx := t.Context()
+
+	primary := &appsv1.Deployment{}
+	require.NoError(t, r.Get(ctx, client.ObjectKeyFromObject(instance), primary))
+	canary := &appsv1.Deployment{}
+	require.NoError(t, r.Get(ctx, types.NamespacedName{Name: instance.Name + CanaryNameSuffix, Namespace: instance.Namespace}, canary))
+
+	primaryPods := labels.Set(primary.Spec.Template.Labels)
+	canaryPods := labels.Set(canary.Spec.Template.Labels)
+
+	primarySelector, err := metav1.LabelSelectorAsSelector(primary.Spec.Selector)
+	require.NoError(t, err)
+	assert.False(t, primarySelector.Matches(canaryPods), "primary selector should not match canary pods")
+	canarySelector, err := metav1.LabelSelectorAsSelector(canary.Spec.Selector)
+	require.NoError(t, err)
+	assert.False(t, canarySelector.Matches(primaryPods), "canary selector should not match primary pods")
+	assert.True(t, canarySelector.Matches(canaryPods))
+
+	service := &corev1.Service{}
+	require.NoError(t, r.Get(ctx, types.NamespacedName{Name: instance.Name + "-service", Namespace: instance.Namespace}, service))
+	serviceSelector := labels.SelectorFromSet(service.Spec.Selector)
+	assert.True(t, serviceSelector.Matches(primaryPods), "Service should select primary pods")
+	assert.True(t, serviceSelector.Matches(canaryPods), "Service should select canary pods")
+
+	networkPolicy := &networkingv1.NetworkPolicy{}
+	require.NoError(t, r.Get(ctx, types.NamespacedName{Name: instance.Name + "-network-policy", Namespace: instance.Namespace}, networkPolicy))
+	policySelector, err := metav1.LabelSelectorAsSelector(&networkPolicy.Spec.PodSelector)
+	require.NoError(t, err)
+	assert.True(t, policySelector.Matches(primaryPods), "NetworkPolicy should cover primary pods")
+	assert.True(t, policySelector.Matches(canaryPods), "NetworkPolicy should cover canary pods")
+}
+
+func TestCanaryOnExistingInstall(t *testing.T) {
+	instance := newCanaryTestInstance(false)
+	r := newCanaryTestReconciler(t, instance)
+	ctx := t.Context()
+
+	// An install that predates the canary keeps its primary Deployment.
+	require.NoError(t, r.reconcileResources(ctx, instance))
+	before := &appsv1.Deployment{}
+	require.NoError(t, r.Get(ctx, client.ObjectKeyFromObject(instance), before))
+
+	instance.Spec.Rollout = newCanaryTestInstance(true).Spec.Rollout
+	require.NoError(t, r.reconcileResources(ctx, instance))
+
+	after := &appsv1.Deployment{}
+	require.NoError(t, r.Get(ctx, client.ObjectKeyFromObject(instance), after))
+	assert.Equal(t, before.UID, after.UID, "primary Deployment should not be recreated")
+	assert.Equal(t, before.Spec.Selector, after.Spec.Selector, "primary selector should not change")
+	assertCanaryShareable(t, r, instance)
+
+	// Removing the canary deletes it and restores the app label on the Service.
+	instance.Spec.Rollout = nil
+	require.NoError(t, r.reconcileResources(ctx, instance))
+	err := r.Get(ctx, types.NamespacedName{Name: instance.Name + CanaryNameSuffix, Namespace: instance.Namespace}, &appsv1.Deployment{})
+	assert.True(t, k8serrors.IsNotFound(err), "canary Deployment should be deleted")
+	service := &corev1.Service{}
+	require.NoError(t, r.Get(ctx, types.NamespacedName{Name: instance.Name + "-service", Namespace: instance.Namespace}, service))
+	assert.Equal(t, llamav1alpha1.DefaultLabelValue, service.Spec.Selector[llamav1alpha1.DefaultLabelKey])
+}
+
+func TestCanaryOnFreshInstall(t *testing.T) {
+	instance := newCanaryTestInstance(true)
+	r := newCanaryTestReconciler(t, instance)
+
+	require.NoError(t, r.reconcileResources(t.Context(), instance))
+	assertCanaryShareable(t, r, instance)
+
+	// A second reconcile finds nothing to fix.
+	require.NoError(t, r.reconcileResources(t.Context(), instance))
+	assertCanaryShareable(t, r, instance)
+}
+
+func TestCanaryWithLegacySelectorIsRecreated(t *testing.T) {
+	instance := newCanaryTestInstance(true)
+	r := newCanaryTestReconciler(t, instance)
+	ctx := t.Context()
+
+	// Earlier canaries reused the primary selector plus the track label.
+	legacyLabels := map[string]string{
+		llamav1alpha1.DefaultLabelKey: llamav1alpha1.DefaultLabelValue,
+		instanceLabelKey:              instance.Name,
+		RolloutTrackLabelKey:          RolloutTrackCanary,
+	}
+	legacy := &appsv1.Deployment{
+		ObjectMeta: metav1.ObjectMeta{Name: instance.Name + CanaryNameSuffix, Namespace: instance.Namespace},
+		Spec: appsv1.DeploymentSpec{
+			Selector: &metav1.LabelSelector{MatchLabels: legacyLabels},
+			Template: corev1.PodTemplateSpec{
+				ObjectMeta: metav1.ObjectMeta{Labels: legacyLabels},
+				Spec:       corev1.PodSpec{Containers: []corev1.Container{{Name: "llama-stack", Image: "old"}}},
+			},
+		},
+	}
+	require.NoError(t, ctrl.SetControllerReference(instance, legacy, r.Scheme))
+	require.NoError(t, r.Create(ctx, legacy))
+
+	require.NoError(t, r.reconcileResources(ctx, instance))
+	assertCanaryShareable(t, r, instance)
+}
+
+func TestCanaryReplicas(t *testing.T) {
+	weight := func(w int32) *int32 { return &w }
+	primary := func(replicas int32) *appsv1.Deployment {
+		return &appsv1.Deployment{Spec: appsv1.DeploymentSpec{Replicas: &replicas}}
+	}
+
+	tests := []struct {
+		name     string
+		canary   llamav1alpha1.CanarySpec
+		primary  *appsv1.Deployment
+		expected int32
+	}{
+		{name: "replicas without weight", canary: llamav1alpha1.CanarySpec{Replicas: 2}, primary: primary(4), expected: 2},
+		{name: "half of the traffic", canary: llamav1alpha1.CanarySpec{Replicas: 1, Weight: weight(50)}, primary: primary(3), expected: 3},
+		{name: "a quarter of the traffic", canary: llamav1alpha1.CanarySpec{Replicas: 1, Weight: weight(25)}, primary: primary(6), expected: 2},
+		{name: "small weight rounds up to one pod", canary: llamav1alpha1.CanarySpec{Replicas: 5, Weight: weight(1)}, primary: primary(2), expected: 1},
+		{name: "unset primary replicas count as one", canary: llamav1alpha1.CanarySpec{Weight: weight(50)}, primary: &appsv1.Deployment{}, expected: 1},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, canaryReplicas(&tt.canary, tt.primary))
+		})
+	}
+}
+
+func TestPromoteCanary(t *testing.T) {
+	instance := newCanaryTestInstance(true)
+	r := newCanaryTestReconciler(t, instance)
+	ctx := t.Context()
+
+	require.NoError(t, r.reconcileResources(ctx, instance))
+
+	require.NoError(t, r.Get(ctx, client.ObjectKeyFromObject(instance), instance))
+	instance.Annotations = map[string]string{llamav1alpha1.PromoteCanaryAnnotationKey: "true"}
+	require.NoError(t, r.Update(ctx, instance))
+
+	require.NoError(t, r.promoteCanary(ctx, instance))
+	require.NoError(t, r.reconcileResources(ctx, instance))
+
+	promoted := &llamav1alpha1.LlamaStackDistribution{}
+	require.NoError(t, r.Get(ctx, client.ObjectKeyFromObject(instance), promoted))
+	assert.NotContains(t, promoted.Annotations, llamav1alpha1.PromoteCanaryAnnotationKey)
+	assert.Nil(t, promoted.Spec.Rollout, "the canary should be removed from the spec")
+	require.NotNil(t, promoted.Spec.Server.UserConfig)
+	assert.Equal(t, "canary-config", promoted.Spec.Server.UserConfig.ConfigMapName)
+
+	err := r.Get(ctx, types.NamespacedName{Name: instance.Name + CanaryNameSuffix, Namespace: instance.Namespace}, &appsv1.Deployment{})
+	assert.True(t, k8serrors.IsNotFound(err), "canary Deployment should be deleted after promotion")
+}
@@ -19,16 +19,20 @@ package controllers
 import (
 	"bytes"
 	"context"
+	"crypto/sha256"
 	"crypto/x509"
+	"encoding/hex"
 	"encoding/json"
 	"encoding/pem"
 	"errors"
 	"fmt"
 	"io"
+	"maps"
 	"net/http"
 	"net/url"
 	"os"
 	"slices"
+	"strconv"
 	"strings"
 	"time"
 
@@ -49,6 +53,8 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/record"
+	"k8s.io/utils/clock"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/builder"
 	"sigs.k8s.io/controller-runtime/pkg/client"
@@ -62,7 +68,14 @@ import (
 
 const (
 	operatorConfigData = "llama-stack-operator-config"
-	manifestsBasePath  = "manifests/base"
+	// distributionsConfigData is the optional ConfigMap that adds or overrides distribution images at runtime.
+	distributionsConfigData = "llama-stack-distributions"
+	distributionsConfigKey  = "distributions.json"
+	// distributionHealthPathsKey maps distribution names to a non-default health endpoint.
+	distributionHealthPathsKey = "health-paths.json"
+	// allowedConfigMapNamespacesKey lists the namespaces user config ConfigMaps may be read from.
+	allowedConfigMapNamespacesKey = "allowed-configmap-namespaces"
+	manifestsBasePath             = "manifests/base"
 
 	// CA Bundle related constants.
 	DefaultCABundleKey             = "ca-bundle.crt"
@@ -104,12 +117,34 @@ type LlamaStackDistributionReconciler struct {
 	EnableNetworkPolicy bool
 	// Image mapping overrides
 	ImageMappingOverrides map[string]string
+	// Namespaces other than the instance namespace that user config ConfigMaps may be read from
+	AllowedConfigMapNamespaces []string
+	// Distribution images read from the llama-stack-distributions ConfigMap,
+	// merged over the embedded ClusterInfo.DistributionImages.
+	RuntimeDistributionImages map[string]string
+	// Health endpoints read from the llama-stack-distributions ConfigMap for
+	// distributions that do not serve the default /v1/health.
+	DistributionHealthPaths map[string]string
 	// Cluster info
 	ClusterInfo *cluster.ClusterInfo
 	httpClient  *http.Client
 
 	// Cached operator namespace used for config refresh during reconciliation.
 	operatorNamespace string
+
+	// Recorder emits Kubernetes events for auditable changes. Optional.
+	Recorder record.EventRecorder
+
+	// clock is used to evaluate the failure grace period. Defaults to the real clock.
+	clock clock.PassiveClock
+}
+
+// now returns the current time from the reconciler clock.
+func (r *LlamaStackDistributionReconciler) now() time.Time {
+	if r.clock == nil {
+		return time.Now()
+	}
+	return r.clock.Now()
 }
 
 // hasUserConfigMap checks if the instance has a valid UserConfig with ConfigMapName.
@@ -127,17 +162,37 @@ func (r *LlamaStackDistributionReconciler) getUserConfigMapNamespace(instance *l
 	return instance.Namespace
 }
 
-// hasCABundleConfigMap checks if the instance has a valid TLSConfig with CABundle ConfigMapName.
+// getCABundleConfigs returns the explicitly referenced CA bundle ConfigMaps, caBundle first
+// followed by additionalCABundles.
+func getCABundleConfigs(instance *llamav1alpha1.LlamaStackDistribution) []llamav1alpha1.CABundleConfig {
+	tlsConfig := instance.Spec.Server.TLSConfig
+	if tlsConfig == nil {
+		return nil
+	}
+
+	var bundles []llamav1alpha1.CABundleConfig
+	if tlsConfig.CABundle != nil && tlsConfig.CABundle.ConfigMapName != "" {
+		bundles = append(bundles, *tlsConfig.CABundle)
+	}
+	for _, bundle := range tlsConfig.AdditionalCABundles {
+		if bundle.ConfigMapName != "" {
+			bundles = append(bundles, bundle)
+		}
+	}
+	return bundles
+}
+
+// hasCABundleConfigMap checks if the instance references at least one CA bundle ConfigMap.
 // Returns true if configured, false otherwise.
 func (r *LlamaStackDistributionReconciler) hasCABundleConfigMap(instance *llamav1alpha1.LlamaStackDistribution) bool {
-	return instance.Spec.Server.TLSConfig != nil && instance.Spec.Server.TLSConfig.CABundle != nil && instance.Spec.Server.TLSConfig.CABundle.ConfigMapName != ""
+	return len(getCABundleConfigs(instance)) > 0
 }
 
-// getCABundleConfigMapNamespace returns the resolved CA bundle ConfigMap namespace.
+// getCABundleConfigMapNamespace returns the resolved namespace of a CA bundle ConfigMap.
 // If ConfigMapNamespace is specified, it returns that; otherwise, it returns the instance's namespace.
-func (r *LlamaStackDistributionReconciler) getCABundleConfigMapNamespace(instance *llamav1alpha1.LlamaStackDistribution) string {
-	if instance.Spec.Server.TLSConfig.CABundle.ConfigMapNamespace != "" {
-		return instance.Spec.Server.TLSConfig.CABundle.ConfigMapNamespace
+func (r *LlamaStackDistributionReconciler) getCABundleConfigMapNamespace(instance *llamav1alpha1.LlamaStackDistribution, bundle llamav1alpha1.CABundleConfig) string {
+	if bundle.ConfigMapNamespace != "" {
+		return bundle.ConfigMapNamespace
 	}
 	return instance.Namespace
 }
@@ -175,6 +230,14 @@ func (r *LlamaStackDistributionReconciler) Reconcile(ctx context.Context, req ct
 		return ctrl.Result{}, nil
 	}
 
+	if err := r.defaultDistribution(ctx, instance); err != nil {
+		return ctrl.Result{}, err
+	}
+
+	if err := r.promoteCanary(ctx, instance); err != nil {
+		return ctrl.Result{}, err
+	}
+
 	// Reconcile all resources, storing the error for later.
 	reconcileErr := r.reconcileResources(ctx, instance)
 
@@ -218,6 +281,8 @@ func (r *LlamaStackDistributionReconciler) refreshOperatorConfig(ctx context.Con
 		r.operatorNamespace = operatorNamespace
 	}
 
+	r.refreshDistributionImages(ctx, operatorNamespace)
+
 	configMap := &corev1.ConfigMap{}
 	if err := r.directGet(ctx, types.NamespacedName{
 		Name:      operatorConfigData,
@@ -235,6 +300,40 @@ func (r *LlamaStackDistributionReconciler) refreshOperatorConfig(ctx context.Con
 	}
 
 	r.ImageMappingOverrides = ParseImageMappingOverrides(ctx, configMap.Data)
+	r.AllowedConfigMapNamespaces = ParseAllowedConfigMapNamespaces(ctx, configMap.Data)
+}
+
+// refreshDistributionImages re-reads the optional distributions ConfigMap so that
+// new distribution images and their health paths can be added without restarting the
+// operator. A missing ConfigMap clears both; a malformed key keeps its previous values.
+func (r *LlamaStackDistributionReconciler) refreshDistributionImages(ctx context.Context, operatorNamespace string) {
+	logger := log.FromContext(ctx)
+
+	configMap := &corev1.ConfigMap{}
+	if err := r.directGet(ctx, types.NamespacedName{
+		Name:      distributionsConfigData,
+		Namespace: operatorNamespace,
+	}, configMap); err != nil {
+		if k8serrors.IsNotFound(err) {
+			r.RuntimeDistributionImages = nil
+			r.DistributionHealthPaths = nil
+			return
+		}
+		logger.Error(err, "failed to refresh distributions config")
+		return
+	}
+
+	if images, err := ParseDistributionImages(ctx, configMap.Data); err != nil {
+		logger.Error(err, "failed to parse distributions config, keeping previous distribution images")
+	} else {
+		r.RuntimeDistributionImages = images
+	}
+
+	if healthPaths, err := ParseDistributionHealthPaths(ctx, configMap.Data); err != nil {
+		logger.Error(err, "failed to parse distribution health paths, keeping previous health paths")
+	} else {
+		r.DistributionHealthPaths = healthPaths
+	}
 }
 
 // directGet reads an object via the DirectClient (non-cached) if set, otherwise
@@ -260,13 +359,36 @@ func (r *LlamaStackDistributionReconciler) fetchInstance(ctx context.Context, na
 	return instance, nil
 }
 
+// defaultDistribution fills in the starter distribution when the spec sets neither a
+// name nor an image, which the CRD default misses when a client sends an empty object.
+// The default is written back with an annotation recording that it was applied.
+func (r *LlamaStackDistributionReconciler) defaultDistribution(ctx context.Context, instance *llamav1alpha1.LlamaStackDistribution) error {
+	distribution := instance.Spec.Server.Distribution
+	if distribution.Name != "" || distribution.Image != "" {
+		return nil
+	}
+
+	patch := client.MergeFrom(instance.DeepCopy())
+	instance.Spec.Server.Distribution.Name = llamav1alpha1.DefaultDistributionName
+	if instance.Annotations == nil {
+		instance.Annotations = map[string]string{}
+	}
+	instance.Annotations[llamav1alpha1.DefaultedDistributionAnnotationKey] = llamav1alpha1.DefaultDistributionName
+	if err := r.Patch(ctx, instance, patch); err != nil {
+		return fmt.Errorf("failed to default distribution: %w", err)
+	}
+
+	log.FromContext(ctx).Info("Defaulted distribution", "distribution", llamav1alpha1.DefaultDistributionName)
+	return nil
+}
+
 // determineKindsToExclude returns a list of resource kinds that should be excluded
 // based on the instance specification.
 func (r *LlamaStackDistributionReconciler) determineKindsToExclude(instance *llamav1alpha1.LlamaStackDistribution) []string {
 	var kinds []string
 
-	// Exclude PersistentVolumeClaim if storage is not configured
-	if instance.Spec.Server.Storage == nil {
+	// Exclude PersistentVolumeClaim unless the operator manages the claim
+	if !instance.ManagesPVC() {
 		kinds = append(kinds, "PersistentVolumeClaim")
 	}
 
@@ -444,10 +566,19 @@ func (r *LlamaStackDistributionReconciler) buildManifestContext(ctx context.Cont
 		return nil, err
 	}
 
+	if err := validateBindAddress(instance); err != nil {
+		return nil, err
+	}
+
+	if err := validateExtraArgs(instance); err != nil {
+		return nil, err
+	}
+
 	resolvedImage, err := r.resolveImage(instance.Spec.Server.Distribution)
 	if err != nil {
 		return nil, err
 	}
+	r.recordDistributionChange(instance, resolvedImage)
 
 	container := buildContainerSpec(ctx, r, instance, resolvedImage)
 	podSpec := configurePodStorage(ctx, r, instance, container)
@@ -475,6 +606,12 @@ func (r *LlamaStackDistributionReconciler) buildManifestContext(ctx context.Cont
 		return nil, fmt.Errorf("failed to convert pod spec to map: %w", err)
 	}
 
+	// Get hash of Secrets referenced by env vars so that rotated values roll the pods
+	secretHash, err := r.getReferencedSecretsHash(ctx, instance)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get referenced Secrets hash: %w", err)
+	}
+
 	pdbSpec := buildPodDisruptionBudgetSpec(instance)
 	hpaSpec := buildHPASpec(instance)
 
@@ -482,12 +619,40 @@ func (r *LlamaStackDistributionReconciler) buildManifestContext(ctx context.Cont
 		ResolvedImage:           resolvedImage,
 		ConfigMapHash:           configMapHash,
 		CABundleHash:            caBundleHash,
+		SecretHash:              secretHash,
+		RollToken:               instance.Annotations[llamav1alpha1.RollAnnotationKey],
 		PodSpec:                 podSpecMap,
 		PodDisruptionBudgetSpec: pdbSpec,
 		HPASpec:                 hpaSpec,
 	}, nil
 }
 
+// recordDistributionChange stores the resolved image in the status and, when it differs
+// from the previously rolled out image, records an event and sets the DistributionChanged
+// condition before the Deployment is updated.
+func (r *LlamaStackDistributionReconciler) recordDistributionChange(instance *llamav1alpha1.LlamaStackDistribution, image string) {
+	previous := instance.Status.ResolvedDistribution.Image
+	instance.Status.ResolvedDistribution.Image = image
+
+	// Nothing to compare against on the first rollout.
+	if previous == "" {
+		return
+	}
+
+	if previous == image {
+		if IsConditionTrue(&instance.Status, ConditionTypeDistributionChanged) {
+			SetDistributionChangedCondition(&instance.Status, false, "")
+		}
+		return
+	}
+
+	message := fmt.Sprintf("Distribution image changed from %s to %s", previous, image)
+	SetDistributionChangedCondition(&instance.Status, true, message)
+	if r.Recorder != nil {
+		r.Recorder.Event(instance, corev1.EventTypeNormal, ReasonDistributionChanged, message)
+	}
+}
+
 // reconcileResources reconciles all resources for the LlamaStackDistribution instance.
 func (r *LlamaStackDistributionReconciler) reconcileResources(ctx context.Context, instance *llamav1alpha1.LlamaStackDistribution) error {
 	// Reconcile ConfigMaps first
@@ -495,17 +660,42 @@ func (r *LlamaStackDistributionReconciler) reconcileResources(ctx context.Contex
 		return err
 	}
 
+	// Check the existing claim before rendering a Deployment that would mount it
+	if err := r.validateExistingClaim(ctx, instance); err != nil {
+		return err
+	}
+
 	// Reconcile all manifest-based resources including Deployment, PVC, ServiceAccount, Service, NetworkPolicy.
 	// NetworkPolicy ingress rules are configured via the kustomize transformer plugin.
 	if err := r.reconcileAllManifestResources(ctx, instance); err != nil {
 		return err
 	}
 
+	// Expand the PVC when the requested storage size grows
+	if err := r.reconcilePVCSize(ctx, instance); err != nil {
+		return fmt.Errorf("failed to reconcile PVC size: %w", err)
+	}
+
+	// Drop or restore the PVC owner reference according to storage.retainOnDelete
+	if err := r.reconcilePVCRetention(ctx, instance); err != nil {
+		return fmt.Errorf("failed to reconcile PVC retention: %w", err)
+	}
+
 	// Reconcile Ingress for external access (not part of kustomize manifests)
 	if err := r.reconcileIngress(ctx, instance); err != nil {
 		return fmt.Errorf("failed to reconcile Ingress: %w", err)
 	}
 
+	// Reconcile the Prometheus ServiceMonitor when the Prometheus Operator is installed
+	if err := r.reconcileServiceMonitor(ctx, instance); err != nil {
+		return fmt.Errorf("failed to reconcile ServiceMonitor: %w", err)
+	}
+
+	// Reconcile the canary Deployment, which is derived from the rendered primary Deployment
+	if err := r.reconcileCanaryDeployment(ctx, instance); err != nil {
+		return fmt.Errorf("failed to reconcile canary Deployment: %w", err)
+	}
+
 	return nil
 }
 
@@ -522,13 +712,11 @@ func (r *LlamaStackDistributionReconciler) reconcileConfigMaps(ctx context.Conte
 }
 
 func (r *LlamaStackDistributionReconciler) validateCABundleKeys(instance *llamav1alpha1.LlamaStackDistribution) error {
-	if instance.Spec.Server.TLSConfig == nil || instance.Spec.Server.TLSConfig.CABundle == nil {
-		return nil
-	}
-
-	if len(instance.Spec.Server.TLSConfig.CABundle.ConfigMapKeys) > 0 {
-		if err := validateConfigMapKeys(instance.Spec.Server.TLSConfig.CABundle.ConfigMapKeys); err != nil {
-			return fmt.Errorf("failed to validate CA bundle ConfigMap keys: %w", err)
+	for _, bundle := range getCABundleConfigs(instance) {
+		if len(bundle.ConfigMapKeys) > 0 {
+			if err := validateConfigMapKeys(bundle.ConfigMapKeys); err != nil {
+				return fmt.Errorf("failed to validate CA bundle ConfigMap keys: %w", err)
+			}
 		}
 	}
 
@@ -542,6 +730,16 @@ func (r *LlamaStackDistributionReconciler) reconcileUserAndCABundleConfigMaps(ct
 		}
 	}
 
+	if hasCanary(instance) {
+		if err := r.reconcileUserConfigMap(ctx, canaryInstance(instance)); err != nil {
+			return fmt.Errorf("failed to reconcile canary user ConfigMap: %w", err)
+		}
+	}
+
+	if err := r.cleanupUserConfigMapCopies(ctx, instance); err != nil {
+		return err
+	}
+
 	if r.hasCABundleConfigMap(instance) {
 		if err := r.reconcileCABundleConfigMap(ctx, instance); err != nil {
 			return fmt.Errorf("failed to reconcile CA bundle ConfigMap: %w", err)
@@ -600,6 +798,11 @@ func (r *LlamaStackDistributionReconciler) SetupWithManager(_ context.Context, m
 			handler.EnqueueRequestsFromMapFunc(r.mapConfigMapToReconcileRequests),
 			builder.WithPredicates(r.userConfigMapPredicate()),
 		).
+		Watches(
+			&corev1.Secret{},
+			handler.EnqueueRequestsFromMapFunc(r.mapSecretToReconcileRequests),
+			builder.WithPredicates(predicate.NewPredicateFuncs(isWatchLabeledSecret)),
+		).
 		Owns(&networkingv1.NetworkPolicy{}).
 		Owns(&networkingv1.Ingress{}).
 		Owns(&corev1.PersistentVolumeClaim{}).
@@ -679,6 +882,40 @@ func (r *LlamaStackDistributionReconciler) mapConfigMapToReconcileRequests(ctx c
 	return requests
 }
 
+// mapSecretToReconcileRequests maps a watch-labeled Secret change to the
+// LlamaStackDistribution CR(s) in the same namespace whose env vars reference it.
+func (r *LlamaStackDistributionReconciler) mapSecretToReconcileRequests(ctx context.Context, obj client.Object) []reconcile.Request {
+	logger := log.FromContext(ctx)
+
+	var instances llamav1alpha1.LlamaStackDistributionList
+	if err := r.List(ctx, &instances, client.InNamespace(obj.GetNamespace())); err != nil {
+		logger.Error(err, "failed to list LlamaStackDistribution instances for Secret mapping")
+		return nil
+	}
+
+	var requests []reconcile.Request
+	for i := range instances.Items {
+		instance := &instances.Items[i]
+		if slices.Contains(getReferencedSecretNames(instance), obj.GetName()) {
+			logger.Info("Secret change mapped to LlamaStackDistribution",
+				"secret", obj.GetName(), "namespace", obj.GetNamespace(), "instance", instance.Name)
+			requests = append(requests, reconcile.Request{
+				NamespacedName: types.NamespacedName{
+					Name:      instance.Name,
+					Namespace: instance.Namespace,
+				},
+			})
+		}
+	}
+
+	return requests
+}
+
+// isWatchLabeledSecret returns true if the Secret has opted in to reconcile triggers via the watch label.
+func isWatchLabeledSecret(obj client.Object) bool {
+	return obj.GetLabels()[WatchLabelKey] == WatchLabelValue
+}
+
 // instanceReferencesConfigMap checks if a LlamaStackDistribution instance references
 // a ConfigMap with the given name and namespace.
 func (r *LlamaStackDistributionReconciler) instanceReferencesConfigMap(
@@ -691,20 +928,27 @@ func (r *LlamaStackDistributionReconciler) instanceReferencesConfigMap(
 		return true
 	}
 
-	// CA bundle source ConfigMap.
-	if r.hasCABundleConfigMap(instance) &&
-		instance.Spec.Server.TLSConfig.CABundle.ConfigMapName == cmName &&
-		r.getCABundleConfigMapNamespace(instance) == cmNamespace {
+	// Canary user config ConfigMap.
+	if hasCanary(instance) &&
+		instance.Spec.Rollout.Canary.UserConfig.ConfigMapName == cmName &&
+		r.getUserConfigMapNamespace(canaryInstance(instance)) == cmNamespace {
 		return true
 	}
 
+	// CA bundle source ConfigMaps.
+	for _, bundle := range getCABundleConfigs(instance) {
+		if bundle.ConfigMapName == cmName && r.getCABundleConfigMapNamespace(instance, bundle) == cmNamespace {
+			return true
+		}
+	}
+
 	// ODH trusted CA bundle well-known ConfigMap (same namespace as instance).
 	if cmName == odhTrustedCABundleConfigMap && cmNamespace == instance.Namespace {
 		return true
 	}
 
-	// Operator config well-known ConfigMap.
-	return cmName == operatorConfigData && cmNamespace == r.operatorNamespace
+	// Operator config and distributions well-known ConfigMaps.
+	return (cmName == operatorConfigData || cmName == distributionsConfigData) && cmNamespace == r.operatorNamespace
 }
 
 // userConfigMapPredicate returns a predicate that accepts only ConfigMaps with
@@ -908,15 +1152,101 @@ func (r *LlamaStackDistributionReconciler) updateDeploymentStatus(ctx context.Co
 		SetDeploymentReadyCondition(&instance.Status, true, MessageDeploymentReady)
 	}
 	instance.Status.AvailableReplicas = deployment.Status.ReadyReplicas
+	instance.Status.Workload = nil
+	if deploymentErr == nil {
+		instance.Status.Workload = &llamav1alpha1.WorkloadStatus{
+			EffectiveWorkers: getDeployedWorkers(deployment, getContainerName(instance)),
+			InjectedEnvVars:  getInjectedEnvVarNames(deployment, instance),
+		}
+	}
+	unavailable := deploymentErr == nil && instance.Spec.Replicas > 0 && deployment.Status.ReadyReplicas == 0
+	r.applyFailureGracePeriod(instance, unavailable)
 	return deploymentReady, nil
 }
 
+// getDeployedWorkers returns the LLS_WORKERS value of the named container in the deployment,
+// or 0 when it is missing or not a number. The last entry wins, matching how the kubelet
+// resolves duplicate env vars.
+func getDeployedWorkers(deployment *appsv1.Deployment, containerName string) int32 {
+	var workers int32
+	for _, container := range deployment.Spec.Template.Spec.Containers {
+		if container.Name != containerName {
+			continue
+		}
+		for _, env := range container.Env {
+			if env.Name != "LLS_WORKERS" {
+				continue
+			}
+			parsed, err := strconv.ParseInt(env.Value, 10, 32)
+			if err != nil {
+				workers = 0
+				continue
+			}
+			workers = int32(parsed)
+		}
+	}
+	return workers
+}
+
+// getInjectedEnvVarNames returns the names of the env vars on the deployed server container
+// that the operator added, i.e. those not declared in containerSpec.env.
+func getInjectedEnvVarNames(deployment *appsv1.Deployment, instance *llamav1alpha1.LlamaStackDistribution) []string {
+	userEnv := make(map[string]struct{}, len(instance.Spec.Server.ContainerSpec.Env))
+	for _, env := range instance.Spec.Server.ContainerSpec.Env {
+		userEnv[env.Name] = struct{}{}
+	}
+
+	var names []string
+	containerName := getContainerName(instance)
+	for _, container := range deployment.Spec.Template.Spec.Containers {
+		if container.Name != containerName {
+			continue
+		}
+		for _, env := range container.Env {
+			if _, declared := userEnv[env.Name]; declared || slices.Contains(names, env.Name) {
+				continue
+			}
+			names = append(names, env.Name)
+		}
+	}
+	return names
+}
+
+// applyFailureGracePeriod tracks how long the deployment has had no ready replicas and
+// marks the distribution as Failed once that exceeds the configured grace period.
+// Until then the phase set by updateDeploymentStatus (Initializing) is kept.
+func (r *LlamaStackDistributionReconciler) applyFailureGracePeriod(instance *llamav1alpha1.LlamaStackDistribution, unavailable bool) {
+	if !unavailable {
+		instance.Status.UnavailableSince = nil
+		return
+	}
+
+	now := r.now()
+	if instance.Status.UnavailableSince == nil {
+		since := metav1.NewTime(now.UTC())
+		instance.Status.UnavailableSince = &since
+		return
+	}
+
+	gracePeriod := llamav1alpha1.DefaultFailureGracePeriod.Duration
+	if instance.Spec.FailureGracePeriod != nil {
+		gracePeriod = instance.Spec.FailureGracePeriod.Duration
+	}
+
+	unavailableFor := now.Sub(instance.Status.UnavailableSince.Time)
+	if unavailableFor >= gracePeriod {
+		instance.Status.Phase = llamav1alpha1.LlamaStackDistributionPhaseFailed
+		SetDeploymentReadyCondition(&instance.Status, false,
+			fmt.Sprintf("%s: no ready replicas for %s", MessageDeploymentFailed, unavailableFor.Round(time.Second)))
+	}
+}
+
 func (r *LlamaStackDistributionReconciler) updateStorageStatus(ctx context.Context, instance *llamav1alpha1.LlamaStackDistribution) {
-	if instance.Spec.Server.Storage == nil {
+	if !instance.HasPersistentStorage() {
 		return
 	}
 	pvc := &corev1.PersistentVolumeClaim{}
-	err := r.Get(ctx, types.NamespacedName{Name: instance.Name + "-pvc", Namespace: instance.Namespace}, pvc)
+	err := r.getStoragePVC(ctx, instance, pvc)
 	if err != nil {
 		SetStorageReadyCondition(&instance.Status, false, fmt.Sprintf("Failed to get PVC: %v", err))
 		return
@@ -956,7 +1286,7 @@ func (r *LlamaStackDistributionReconciler) updateServiceStatus(ctx context.Conte
 }
 
 func (r *LlamaStackDistributionReconciler) updateDistributionConfig(instance *llamav1alpha1.LlamaStackDistribution) {
-	instance.Status.DistributionConfig.AvailableDistributions = r.ClusterInfo.DistributionImages
+	instance.Status.DistributionConfig.AvailableDistributions = r.distributionImages()
 	var activeDistribution string
 	if instance.Spec.Server.Distribution.Name != "" {
 		activeDistribution = instance.Spec.Server.Distribution.Name
@@ -978,6 +1308,11 @@ func (r *LlamaStackDistributionReconciler) reconcileUserConfigMap(ctx context.Co
 	// Determine the ConfigMap namespace - default to the same namespace as the LlamaStackDistribution.
 	configMapNamespace := r.getUserConfigMapNamespace(instance)
 
+	if configMapNamespace != instance.Namespace && !slices.Contains(r.AllowedConfigMapNamespaces, configMapNamespace) {
+		return fmt.Errorf("failed to read ConfigMap %s/%s: namespace %s is not in the operator's allowed-configmap-namespaces",
+			configMapNamespace, instance.Spec.Server.UserConfig.ConfigMapName, configMapNamespace)
+	}
+
 	logger.V(1).Info("Validating referenced ConfigMap exists",
 		"configMapName", instance.Spec.Server.UserConfig.ConfigMapName,
 		"configMapNamespace", configMapNamespace)
@@ -998,49 +1333,228 @@ func (r *LlamaStackDistributionReconciler) reconcileUserConfigMap(ctx context.Co
 		return fmt.Errorf("failed to fetch ConfigMap %s/%s: %w", configMapNamespace, instance.Spec.Server.UserConfig.ConfigMapName, err)
 	}
 
+	if err := validateUserConfigData(configMap, instance.IsStorageDisabled()); err != nil {
+		return err
+	}
+
 	logger.V(1).Info("User ConfigMap found and validated",
 		"configMap", configMap.Name,
 		"namespace", configMap.Namespace,
 		"dataKeys", len(configMap.Data))
+
+	// Pods can only mount ConfigMaps from their own namespace, so copy the config over.
+	if configMapNamespace != instance.Namespace {
+		return r.reconcileUserConfigMapCopy(ctx, instance, configMap)
+	}
 	return nil
 }
 
-// reconcileCABundleConfigMap validates that the referenced CA bundle ConfigMap exists.
-func (r *LlamaStackDistributionReconciler) reconcileCABundleConfigMap(ctx context.Context, instance *llamav1alpha1.LlamaStackDistribution) error {
-	logger := log.FromContext(ctx)
+// validateUserConfigData checks that the user ConfigMap carries a config.yaml that parses and
+// declares a version, so that malformed configs fail reconcile instead of crashing the server.
+// Without a storage volume the metadata store must not be sqlite, whose file would be lost
+// with the container.
+func validateUserConfigData(configMap *corev1.ConfigMap, storageDisabled bool) error {
+	data, ok := configMap.Data["config.yaml"]
+	if !ok {
+		return fmt.Errorf("failed to find key 'config.yaml' in ConfigMap %s/%s", configMap.Namespace, configMap.Name)
+	}
+
+	var config map[string]any
+	if err := yaml.Unmarshal([]byte(data), &config); err != nil {
+		return fmt.Errorf("failed to parse config.yaml in ConfigMap %s/%s: %w", configMap.Namespace, configMap.Name, err)
+	}
+	if version, ok := config["version"]; !ok || version == nil || fmt.Sprint(version) == "" {
+		return fmt.Errorf("failed to validate config.yaml in ConfigMap %s/%s: missing version field", configMap.Namespace, configMap.Name)
+	}
+
+	if storageDisabled {
+		storeType := metadataStoreType(config)
+		if storeType == "" || strings.Contains(storeType, "sqlite") {
+			return fmt.Errorf("failed to validate config.yaml in ConfigMap %s/%s: storage.disabled requires a non-sqlite metadata store, got %q",
+				configMap.Namespace, configMap.Name, storeType)
+		}
+	}
+	return nil
+}
+
+// metadataStoreType returns the type of the metadata store declared in a llama-stack config,
+// either as metadata_store.type or, in newer configs, as the type of the backend referenced
+// by storage.stores.metadata. It returns an empty string when none is declared, in which
+// case llama-stack falls back to sqlite.
+func metadataStoreType(config map[string]any) string {
+	if store, ok := config["metadata_store"].(map[string]any); ok {
+		storeType, _ := store["type"].(string)
+		return storeType
+	}
+
+	storage, ok := config["storage"].(map[string]any)
+	if !ok {
+		return ""
+	}
+	stores, _ := storage["stores"].(map[string]any)
+	metadata, _ := stores["metadata"].(map[string]any)
+	backendName, ok := metadata["backend"].(string)
+	if !ok {
+		return ""
+	}
+	backends, _ := storage["backends"].(map[string]any)
+	backend, _ := backends[backendName].(map[string]any)
+	backendType, _ := backend["type"].(string)
+	return backendType
+}
+
+// reconcileUserConfigMapCopy creates or updates the owned copy of a user config ConfigMap
+// that lives in another namespace.
+func (r *LlamaStackDistributionReconciler) reconcileUserConfigMapCopy(
+	ctx context.Context,
+	instance *llamav1alpha1.LlamaStackDistribution,
+	source *corev1.ConfigMap,
+) error {
+	labels := userConfigMapCopyLabels(instance)
+	labels[WatchLabelKey] = WatchLabelValue
+	desired := &corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      getUserConfigMapMountName(instance),
+			Namespace: instance.Namespace,
+			Labels:    labels,
+		},
+		Data:       source.Data,
+		BinaryData: source.BinaryData,
+	}
+	if err := ctrl.SetControllerReference(instance, desired, r.Scheme); err != nil {
+		return fmt.Errorf("failed to set controller reference: %w", err)
+	}
+
+	existing := &corev1.ConfigMap{}
+	err := r.directGet(ctx, types.NamespacedName{Name: desired.Name, Namespace: desired.Namespace}, existing)
+	if k8serrors.IsNotFound(err) {
+		log.FromContext(ctx).Info("Copying user ConfigMap into the instance namespace",
+			"source", source.Namespace+"/"+source.Name, "configMap", desired.Name)
+		if err := r.Create(ctx, desired); err != nil {
+			return fmt.Errorf("failed to create user ConfigMap copy: %w", err)
+		}
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("failed to get user ConfigMap copy: %w", err)
+	}
+
+	// A copy that lost its owner reference is adopted again; one controlled by another
+	// object is left alone.
+	if owner := metav1.GetControllerOf(existing); owner != nil && owner.UID != instance.UID {
+		return fmt.Errorf("failed to copy user ConfigMap: %s/%s exists and is not owned by this instance",
+			desired.Namespace, desired.Name)
+	}
+
+	if metav1.IsControlledBy(existing, instance) &&
+		maps.Equal(existing.Labels, desired.Labels) &&
+		maps.Equal(existing.Data, desired.Data) &&
+		maps.EqualFunc(existing.BinaryData, desired.BinaryData, bytes.Equal) {
+		return nil
+	}
+
+	desired.ResourceVersion = existing.ResourceVersion
+	if err := r.Update(ctx, desired); err != nil {
+		return fmt.Errorf("failed to update user ConfigMap copy: %w", err)
+	}
+	return nil
+}
+
+// userConfigMapCopyLabels returns the labels identifying the user ConfigMap copies of an instance.
+func userConfigMapCopyLabels(instance *llamav1alpha1.LlamaStackDistribution) map[string]string {
+	return map[string]string{
+		"app.kubernetes.io/managed-by": "llama-stack-operator",
+		"app.kubernetes.io/instance":   instance.Name,
+		"app.kubernetes.io/component":  "user-config",
+	}
+}
+
+// cleanupUserConfigMapCopies deletes user ConfigMap copies that the primary and canary
+// configs no longer reference, e.g. after the source was renamed or moved back into
+// the instance namespace. It only runs once the current configs have been reconciled,
+// so a copy whose source is temporarily missing is kept for the running pods.
+func (r *LlamaStackDistributionReconciler) cleanupUserConfigMapCopies(ctx context.Context, instance *llamav1alpha1.LlamaStackDistribution) error {
+	referenced := map[string]struct{}{}
+	instances := []*llamav1alpha1.LlamaStackDistribution{instance}
+	if hasCanary(instance) {
+		instances = append(instances, canaryInstance(instance))
+	}
+	for _, inst := range instances {
+		if r.hasUserConfigMap(inst) && r.getUserConfigMapNamespace(inst) != inst.Namespace {
+			referenced[getUserConfigMapMountName(inst)] = struct{}{}
+		}
+	}
 
+	copies := &corev1.ConfigMapList{}
+	if err := r.List(ctx, copies, client.InNamespace(instance.Namespace),
+		client.MatchingLabels(userConfigMapCopyLabels(instance))); err != nil {
+		return fmt.Errorf("failed to list user ConfigMap copies: %w", err)
+	}
+
+	for i := range copies.Items {
+		configMap := &copies.Items[i]
+		if _, ok := referenced[configMap.Name]; ok || !metav1.IsControlledBy(configMap, instance) {
+			continue
+		}
+		log.FromContext(ctx).Info("Deleting unreferenced user ConfigMap copy", "configMap", configMap.Name)
+		if err := r.Delete(ctx, configMap); err != nil && !k8serrors.IsNotFound(err) {
+			return fmt.Errorf("failed to delete user ConfigMap copy %s: %w", configMap.Name, err)
+		}
+	}
+	return nil
+}
+
+// reconcileCABundleConfigMap validates that the referenced CA bundle ConfigMaps exist.
+func (r *LlamaStackDistributionReconciler) reconcileCABundleConfigMap(ctx context.Context, instance *llamav1alpha1.LlamaStackDistribution) error {
 	if !r.hasCABundleConfigMap(instance) {
-		logger.V(1).Info("No CA bundle ConfigMap specified, skipping")
+		log.FromContext(ctx).V(1).Info("No CA bundle ConfigMap specified, skipping")
 		return nil
 	}
 
+	for _, bundle := range getCABundleConfigs(instance) {
+		if err := r.validateCABundleConfigMap(ctx, instance, bundle); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// validateCABundleConfigMap validates that a referenced CA bundle ConfigMap exists and
+// contains the expected keys.
+func (r *LlamaStackDistributionReconciler) validateCABundleConfigMap(
+	ctx context.Context,
+	instance *llamav1alpha1.LlamaStackDistribution,
+	bundle llamav1alpha1.CABundleConfig,
+) error {
+	logger := log.FromContext(ctx)
+
 	// Determine the ConfigMap namespace - default to the same namespace as the LlamaStackDistribution.
-	configMapNamespace := r.getCABundleConfigMapNamespace(instance)
+	configMapNamespace := r.getCABundleConfigMapNamespace(instance, bundle)
 
 	logger.V(1).Info("Validating referenced CA bundle ConfigMap exists",
-		"configMapName", instance.Spec.Server.TLSConfig.CABundle.ConfigMapName,
+		"configMapName", bundle.ConfigMapName,
 		"configMapNamespace", configMapNamespace)
 
 	// Read via direct client — user CA bundle ConfigMaps lack operator labels
 	configMap := &corev1.ConfigMap{}
 	err := r.directGet(ctx, types.NamespacedName{
-		Name:      instance.Spec.Server.TLSConfig.CABundle.ConfigMapName,
+		Name:      bundle.ConfigMapName,
 		Namespace: configMapNamespace,
 	}, configMap)
 	if err != nil {
 		if k8serrors.IsNotFound(err) {
 			logger.Error(err, "Referenced CA bundle ConfigMap not found",
-				"configMapName", instance.Spec.Server.TLSConfig.CABundle.ConfigMapName,
+				"configMapName", bundle.ConfigMapName,
 				"configMapNamespace", configMapNamespace)
-			return fmt.Errorf("failed to find referenced CA bundle ConfigMap %s/%s", configMapNamespace, instance.Spec.Server.TLSConfig.CABundle.ConfigMapName)
+			return fmt.Errorf("failed to find referenced CA bundle ConfigMap %s/%s", configMapNamespace, bundle.ConfigMapName)
 		}
-		return fmt.Errorf("failed to fetch CA bundle ConfigMap %s/%s: %w", configMapNamespace, instance.Spec.Server.TLSConfig.CABundle.ConfigMapName, err)
+		return fmt.Errorf("failed to fetch CA bundle ConfigMap %s/%s: %w", configMapNamespace, bundle.ConfigMapName, err)
 	}
 
 	// Validate that the specified keys exist in the ConfigMap
 	var keysToValidate []string
-	if len(instance.Spec.Server.TLSConfig.CABundle.ConfigMapKeys) > 0 {
-		keysToValidate = instance.Spec.Server.TLSConfig.CABundle.ConfigMapKeys
+	if len(bundle.ConfigMapKeys) > 0 {
+		keysToValidate = bundle.ConfigMapKeys
 	} else {
 		// Default to DefaultCABundleKey when no keys are specified
 		keysToValidate = []string{DefaultCABundleKey}
@@ -1049,16 +1563,16 @@ func (r *LlamaStackDistributionReconciler) reconcileCABundleConfigMap(ctx contex
 	for _, key := range keysToValidate {
 		if _, exists := configMap.Data[key]; !exists {
 			logger.Error(err, "CA bundle key not found in ConfigMap",
-				"configMapName", instance.Spec.Server.TLSConfig.CABundle.ConfigMapName,
+				"configMapName", bundle.ConfigMapName,
 				"configMapNamespace", configMapNamespace,
 				"key", key)
-			return fmt.Errorf("failed to find CA bundle key '%s' in ConfigMap %s/%s", key, configMapNamespace, instance.Spec.Server.TLSConfig.CABundle.ConfigMapName)
+			return fmt.Errorf("failed to find CA bundle key '%s' in ConfigMap %s/%s", key, configMapNamespace, bundle.ConfigMapName)
 		}
 
 		// Note: Detailed PEM validation is performed later
 		// in extractValidCertificates() which validates all PEM blocks.
 		logger.V(1).Info("CA bundle key found",
-			"configMapName", instance.Spec.Server.TLSConfig.CABundle.ConfigMapName,
+			"configMapName", bundle.ConfigMapName,
 			"configMapNamespace", configMapNamespace,
 			"key", key)
 	}
@@ -1118,6 +1632,72 @@ func (r *LlamaStackDistributionReconciler) getCABundleConfigMapHash(ctx context.
 	return fmt.Sprintf("%s-%s", configMap.ResourceVersion, configMap.Name), nil
 }
 
+// getReferencedSecretsHash calculates a hash over the Secret keys referenced by the
+// container env vars to detect changes such as a rotated API key. Only the referenced
+// values are hashed, so metadata-only writes to a Secret do not roll the pods.
+func (r *LlamaStackDistributionReconciler) getReferencedSecretsHash(ctx context.Context, instance *llamav1alpha1.LlamaStackDistribution) (string, error) {
+	secretKeys := getReferencedSecretKeys(instance)
+	if len(secretKeys) == 0 {
+		return "", nil
+	}
+
+	entries := make([]string, 0, len(secretKeys))
+	for _, secretName := range slices.Sorted(maps.Keys(secretKeys)) {
+		secret := &corev1.Secret{}
+		err := r.directGet(ctx, types.NamespacedName{Name: secretName, Namespace: instance.Namespace}, secret)
+		if err != nil {
+			if k8serrors.IsNotFound(err) {
+				// Optional references may point at a missing Secret; its creation still changes the hash.
+				entries = append(entries, secretName+"=missing")
+				continue
+			}
+			return "", err
+		}
+		for _, key := range secretKeys[secretName] {
+			value, ok := secret.Data[key]
+			if !ok {
+				entries = append(entries, fmt.Sprintf("%s/%s=missing", secretName, key))
+				continue
+			}
+			valueSum := sha256.Sum256(value)
+			entries = append(entries, fmt.Sprintf("%s/%s=%s", secretName, key, hex.EncodeToString(valueSum[:])))
+		}
+	}
+
+	sum := sha256.Sum256([]byte(strings.Join(entries, ",")))
+	return hex.EncodeToString(sum[:8]), nil
+}
+
+// getReferencedSecretKeys returns the sorted, de-duplicated keys referenced through
+// secretKeyRef in the container env vars, grouped by Secret name.
+func getReferencedSecretKeys(instance *llamav1alpha1.LlamaStackDistribution) map[string][]string {
+	keys := map[string][]string{}
+	for _, env := range instance.Spec.Server.ContainerSpec.Env {
+		if env.ValueFrom != nil && env.ValueFrom.SecretKeyRef != nil && env.ValueFrom.SecretKeyRef.Name != "" {
+			ref := env.ValueFrom.SecretKeyRef
+			keys[ref.Name] = append(keys[ref.Name], ref.Key)
+		}
+	}
+	for name, secretKeys := range keys {
+		slices.Sort(secretKeys)
+		keys[name] = slices.Compact(secretKeys)
+	}
+	return keys
+}
+
+// getReferencedSecretNames returns the sorted, de-duplicated names of Secrets
+// referenced through secretKeyRef in the container env vars.
+func getReferencedSecretNames(instance *llamav1alpha1.LlamaStackDistribution) []string {
+	var names []string
+	for _, env := range instance.Spec.Server.ContainerSpec.Env {
+		if env.ValueFrom != nil && env.ValueFrom.SecretKeyRef != nil && env.ValueFrom.SecretKeyRef.Name != "" {
+			names = append(names, env.ValueFrom.SecretKeyRef.Name)
+		}
+	}
+	slices.Sort(names)
+	return slices.Compact(names)
+}
+
 // hasODHTrustedCABundle checks if the ODH trusted CA bundle ConfigMap exists and has valid keys.
 func (r *LlamaStackDistributionReconciler) hasODHTrustedCABundle(ctx context.Context, instance *llamav1alpha1.LlamaStackDistribution) bool {
 	_, keys, err := r.detectODHTrustedCABundle(ctx, instance)
@@ -1197,27 +1777,28 @@ func (c *certificateCollector) concatenate() (string, error) {
 }
 
 func (r *LlamaStackDistributionReconciler) gatherExplicitCABundle(ctx context.Context, instance *llamav1alpha1.LlamaStackDistribution, collector *certificateCollector) error {
-	if !r.hasCABundleConfigMap(instance) {
-		return nil
-	}
+	for _, bundle := range getCABundleConfigs(instance) {
+		configMapNamespace := r.getCABundleConfigMapNamespace(instance, bundle)
+		configMap := &corev1.ConfigMap{}
+		err := r.directGet(ctx, types.NamespacedName{
+			Name:      bundle.ConfigMapName,
+			Namespace: configMapNamespace,
+		}, configMap)
+		if err != nil {
+			return fmt.Errorf("failed to get CA bundle ConfigMap %s/%s: %w",
+				configMapNamespace, bundle.ConfigMapName, err)
+		}
 
-	configMapNamespace := r.getCABundleConfigMapNamespace(instance)
-	configMap := &corev1.ConfigMap{}
-	err := r.directGet(ctx, types.NamespacedName{
-		Name:      instance.Spec.Server.TLSConfig.CABundle.ConfigMapName,
-		Namespace: configMapNamespace,
-	}, configMap)
-	if err != nil {
-		return fmt.Errorf("failed to get CA bundle ConfigMap %s/%s: %w",
-			configMapNamespace, instance.Spec.Server.TLSConfig.CABundle.ConfigMapName, err)
-	}
+		keysToProcess := bundle.ConfigMapKeys
+		if len(keysToProcess) == 0 {
+			keysToProcess = []string{DefaultCABundleKey}
+		}
 
-	keysToProcess := instance.Spec.Server.TLSConfig.CABundle.ConfigMapKeys
-	if len(keysToProcess) == 0 {
-		keysToProcess = []string{DefaultCABundleKey}
+		if err := r.processConfigMapKeys(configMap, keysToProcess, configMapNamespace, bundle.ConfigMapName, collector); err != nil {
+			return err
+		}
 	}
-
-	return r.processConfigMapKeys(configMap, keysToProcess, configMapNamespace, instance.Spec.Server.TLSConfig.CABundle.ConfigMapName, collector)
+	return nil
 }
 
 func (r *LlamaStackDistributionReconciler) gatherODHCABundle(ctx context.Context, instance *llamav1alpha1.LlamaStackDistribution, collector *certificateCollector) error {
@@ -1343,9 +1924,10 @@ func (r *LlamaStackDistributionReconciler) reconcileManagedCABundleConfigMap(ctx
 
 	managedConfigMapName := getManagedCABundleConfigMapName(instance)
 
-	// Check if the managed ConfigMap already exists
+	// Check if the managed ConfigMap already exists. Read it uncached so a copy whose
+	// watch label was removed is still found and repaired rather than re-created.
 	existingConfigMap := &corev1.ConfigMap{}
-	err = r.Get(ctx, types.NamespacedName{
+	err = r.directGet(ctx, types.NamespacedName{
 		Name:      managedConfigMapName,
 		Namespace: instance.Namespace,
 	}, existingConfigMap)
@@ -1384,13 +1966,16 @@ func (r *LlamaStackDistributionReconciler) reconcileManagedCABundleConfigMap(ctx
 		}
 		logger.Info("Successfully created managed CA bundle ConfigMap", "configMap", managedConfigMapName)
 	} else {
-		// ConfigMap exists, update it if the data has changed
-		if existingConfigMap.Data[ManagedCABundleKey] != caBundleData {
+		// ConfigMap exists, update it if the data, labels or owner reference have drifted
+		if existingConfigMap.Data[ManagedCABundleKey] != caBundleData || managedMetadataDrifted(instance, existingConfigMap, desiredConfigMap) {
 			logger.Info("Updating managed CA bundle ConfigMap", "configMap", managedConfigMapName)
 			// Use Patch instead of Update to avoid race conditions
 			patch := client.MergeFrom(existingConfigMap.DeepCopy())
 			existingConfigMap.Data = desiredConfigMap.Data
 			existingConfigMap.Labels = desiredConfigMap.Labels
+			if refErr := ctrl.SetControllerReference(instance, existingConfigMap, r.Scheme); refErr != nil {
+				return fmt.Errorf("failed to set controller reference on managed CA bundle ConfigMap: %w", refErr)
+			}
 			if err := r.Patch(ctx, existingConfigMap, patch); err != nil {
 				if k8serrors.IsConflict(err) {
 					// Conflict detected, will be retried by controller
@@ -1407,6 +1992,20 @@ func (r *LlamaStackDistributionReconciler) reconcileManagedCABundleConfigMap(ctx
 	return nil
 }
 
+// managedMetadataDrifted reports whether an operator-managed object lost the labels or the
+// controller reference the operator sets on it, e.g. after a manual edit.
+func managedMetadataDrifted(instance *llamav1alpha1.LlamaStackDistribution, existing, desired metav1.Object) bool {
+	if !metav1.IsControlledBy(existing, instance) {
+		return true
+	}
+	for key, value := range desired.GetLabels() {
+		if existing.GetLabels()[key] != value {
+			return true
+		}
+	}
+	return false
+}
+
 // detectODHTrustedCABundle checks if the well-known ODH trusted CA bundle ConfigMap
 // exists in the same namespace as the LlamaStackDistribution and returns its available keys.
 // Returns the ConfigMap and a list of data keys if found, or nil and empty slice if not found.
@@ -1520,14 +2119,16 @@ func NewLlamaStackDistributionReconciler(ctx context.Context, client client.Clie
 	imageMappingOverrides := ParseImageMappingOverrides(ctx, configMap.Data)
 
 	return &LlamaStackDistributionReconciler{
-		Client:                client,
-		Scheme:                scheme,
-		DirectClient:          directClient,
-		EnableNetworkPolicy:   enableNetworkPolicy,
-		ImageMappingOverrides: imageMappingOverrides,
-		ClusterInfo:           clusterInfo,
-		httpClient:            &http.Client{Timeout: 5 * time.Second},
-		operatorNamespace:     operatorNamespace,
+		Client:                     client,
+		Scheme:                     scheme,
+		DirectClient:               directClient,
+		EnableNetworkPolicy:        enableNetworkPolicy,
+		ImageMappingOverrides:      imageMappingOverrides,
+		AllowedConfigMapNamespaces: ParseAllowedConfigMapNamespaces(ctx, configMap.Data),
+		ClusterInfo:                clusterInfo,
+		httpClient:                 &http.Client{Timeout: 5 * time.Second},
+		operatorNamespace:          operatorNamespace,
+		clock:                      clock.RealClock{},
 	}, nil
 }
 
@@ -1604,6 +2205,86 @@ func ParseImageMappingOverrides(ctx context.Context, configMapData map[string]st
 	return imageMappingOverrides
 }
 
+// ParseAllowedConfigMapNamespaces parses the allowed-configmap-namespaces key of the operator
+// ConfigMap, a YAML list of namespaces that user config ConfigMaps may be read from.
+func ParseAllowedConfigMapNamespaces(ctx context.Context, configMapData map[string]string) []string {
+	namespacesYAML, exists := configMapData[allowedConfigMapNamespacesKey]
+	if !exists {
+		return nil
+	}
+
+	var namespaces []string
+	if err := yaml.Unmarshal([]byte(namespacesYAML), &namespaces); err != nil {
+		log.FromContext(ctx).V(1).Info("failed to parse allowed-configmap-namespaces YAML", "error", err)
+		return nil
+	}
+	return namespaces
+}
+
+// ParseDistributionImages parses the distributions.json key of the distributions ConfigMap.
+// The format matches the embedded distributions.json: a JSON object mapping distribution
+// names to images. Entries with an invalid image reference are skipped.
+func ParseDistributionImages(ctx context.Context, configMapData map[string]string) (map[string]string, error) {
+	logger := log.FromContext(ctx)
+
+	distributionsJSON, exists := configMapData[distributionsConfigKey]
+	if !exists {
+		return nil, nil
+	}
+
+	var distributions map[string]string
+	if err := json.Unmarshal([]byte(distributionsJSON), &distributions); err != nil {
+		return nil, fmt.Errorf("failed to parse %s: %w", distributionsConfigKey, err)
+	}
+
+	distributionImages := make(map[string]string, len(distributions))
+	for distributionName, image := range distributions {
+		if _, err := name.ParseReference(image); err != nil {
+			logger.V(1).Info(
+				"skipping invalid distribution image",
+				"distribution", distributionName,
+				"image", image,
+				"error", err,
+			)
+			continue
+		}
+		distributionImages[distributionName] = image
+	}
+
+	return distributionImages, nil
+}
+
+// ParseDistributionHealthPaths parses the health-paths.json key of the distributions
+// ConfigMap. Paths that are not absolute are skipped.
+func ParseDistributionHealthPaths(ctx context.Context, configMapData map[string]string) (map[string]string, error) {
+	logger := log.FromContext(ctx)
+
+	healthPathsJSON, exists := configMapData[distributionHealthPathsKey]
+	if !exists {
+		return nil, nil
+	}
+
+	var paths map[string]string
+	if err := json.Unmarshal([]byte(healthPathsJSON), &paths); err != nil {
+		return nil, fmt.Errorf("failed to parse %s: %w", distributionHealthPathsKey, err)
+	}
+
+	healthPaths := make(map[string]string, len(paths))
+	for distributionName, path := range paths {
+		if !strings.HasPrefix(path, "/") {
+			logger.V(1).Info(
+				"skipping invalid distribution health path",
+				"distribution", distributionName,
+				"path", path,
+			)
+			continue
+		}
+		healthPaths[distributionName] = path
+	}
+
+	return healthPaths, nil
+}
+
 // NewTestReconciler creates a reconciler for testing, allowing injection of a custom http client and feature flags.
 func NewTestReconciler(client client.Client, scheme *runtime.Scheme, clusterInfo *cluster.ClusterInfo,
 	httpClient *http.Client, enableNetworkPolicy bool) *LlamaStackDistributionReconciler {
@@ -1614,6 +2295,7 @@ func NewTestReconciler(client client.Client, scheme *runtime.Scheme, clusterInfo
 		httpClient:            httpClient,
 		EnableNetworkPolicy:   enableNetworkPolicy,
 		ImageMappingOverrides: make(map[string]string),
+		clock:                 clock.RealClock{},
 	}
 }
 
@@ -1622,6 +2304,11 @@ func (r *LlamaStackDistributionReconciler) MapConfigMapToReconcileRequests(ctx c
 	return r.mapConfigMapToReconcileRequests(ctx, obj)
 }
 
+// MapSecretToReconcileRequests is an exported wrapper for mapSecretToReconcileRequests, for testing.
+func (r *LlamaStackDistributionReconciler) MapSecretToReconcileRequests(ctx context.Context, obj client.Object) []reconcile.Request {
+	return r.mapSecretToReconcileRequests(ctx, obj)
+}
+
 // UserConfigMapPredicate is an exported wrapper for userConfigMapPredicate, for testing.
 func (r *LlamaStackDistributionReconciler) UserConfigMapPredicate() predicate.Funcs {
 	return r.userConfigMapPredicate()
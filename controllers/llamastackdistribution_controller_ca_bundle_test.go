@@ -0,0 +1,117 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controllers
+
+import (
+	"strings"
+	"testing"
+
+	llamav1alpha1 "github.com/llamastack/llama-stack-k8s-operator/api/v1alpha1"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+func TestManagedCABundle_MultipleConfigMaps(t *testing.T) {
+	scheme := runtime.NewScheme()
+	require.NoError(t, clientgoscheme.AddToScheme(scheme))
+	require.NoError(t, llamav1alpha1.AddToScheme(scheme))
+
+	internalCert := generateTestCertPEM(t)
+	partnerCert := generateTestCertPEM(t)
+	internalBundle := &corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{Name: "internal-ca", Namespace: "default"},
+		Data:       map[string]string{DefaultCABundleKey: internalCert},
+	}
+	partnerBundle := &corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{Name: "partner-ca", Namespace: "partners"},
+		Data:       map[string]string{"partner.crt": partnerCert},
+	}
+
+	instance := &llamav1alpha1.LlamaStackDistribution{
+		ObjectMeta: metav1.ObjectMeta{Name: "multi-ca", Namespace: "default", UID: "multi-ca-uid"},
+		Spec: llamav1alpha1.LlamaStackDistributionSpec{
+			Server: llamav1alpha1.ServerSpec{
+				TLSConfig: &llamav1alpha1.TLSConfig{
+					CABundle: &llamav1alpha1.CABundleConfig{ConfigMapName: "internal-ca"},
+					AdditionalCABundles: []llamav1alpha1.CABundleConfig{{
+						ConfigMapName:      "partner-ca",
+						ConfigMapNamespace: "partners",
+						ConfigMapKeys:      []string{"partner.crt"},
+					}},
+				},
+			},
+		},
+	}
+
+	r := &LlamaStackDistributionReconciler{
+		Client: fake.NewClientBuilder().WithScheme(scheme).WithObjects(internalBundle, partnerBundle).Build(),
+		Scheme: scheme,
+	}
+
+	require.NoError(t, r.reconcileCABundleConfigMap(t.Context(), instance))
+	require.NoError(t, r.reconcileManagedCABundle(t.Context(), instance))
+
+	managed := &corev1.ConfigMap{}
+	require.NoError(t, r.Get(t.Context(), types.NamespacedName{
+		Name:      getManagedCABundleConfigMapName(instance),
+		Namespace: "default",
+	}, managed))
+	bundle := managed.Data[ManagedCABundleKey]
+	assert.Contains(t, bundle, strings.TrimSpace(internalCert))
+	assert.Contains(t, bundle, strings.TrimSpace(partnerCert))
+
+	// Both ConfigMaps trigger reconciles for the instance.
+	assert.True(t, r.instanceReferencesConfigMap(instance, "internal-ca", "default"))
+	assert.True(t, r.instanceReferencesConfigMap(instance, "partner-ca", "partners"))
+
+	// The pod mounts the single managed bundle.
+	podSpec := corev1.PodSpec{}
+	configureTLSCABundle(t.Context(), r, instance, &podSpec)
+	require.Len(t, podSpec.Volumes, 1)
+	assert.Equal(t, getManagedCABundleConfigMapName(instance), podSpec.Volumes[0].ConfigMap.Name)
+}
+
+func TestReconcileCABundleConfigMap_MissingAdditionalBundle(t *testing.T) {
+	internalBundle := &corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{Name: "internal-ca", Namespace: "default"},
+		Data:       map[string]string{DefaultCABundleKey: generateTestCertPEM(t)},
+	}
+	instance := &llamav1alpha1.LlamaStackDistribution{
+		ObjectMeta: metav1.ObjectMeta{Name: "multi-ca", Namespace: "default"},
+		Spec: llamav1alpha1.LlamaStackDistributionSpec{
+			Server: llamav1alpha1.ServerSpec{
+				TLSConfig: &llamav1alpha1.TLSConfig{
+					CABundle:            &llamav1alpha1.CABundleConfig{ConfigMapName: "internal-ca"},
+					AdditionalCABundles: []llamav1alpha1.CABundleConfig{{ConfigMapName: "partner-ca"}},
+				},
+			},
+		},
+	}
+	r := &LlamaStackDistributionReconciler{
+		Client: fake.NewClientBuilder().WithScheme(clientgoscheme.Scheme).WithObjects(internalBundle).Build(),
+	}
+
+	err := r.reconcileCABundleConfigMap(t.Context(), instance)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "failed to find referenced CA bundle ConfigMap default/partner-ca")
+}
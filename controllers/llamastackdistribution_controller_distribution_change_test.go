@@ -0,0 +1,113 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controllers
+
+import (
+	"testing"
+
+	llamav1alpha1 "github.com/llamastack/llama-stack-k8s-operator/api/v1alpha1"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	"k8s.io/client-go/tools/record"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+func TestRecordDistributionChange(t *testing.T) {
+	recorder := record.NewFakeRecorder(10)
+	r := &LlamaStackDistributionReconciler{Recorder: recorder}
+	instance := &llamav1alpha1.LlamaStackDistribution{}
+
+	// First rollout only records the image.
+	r.recordDistributionChange(instance, "quay.io/llamastack/distribution:v1")
+	assert.Equal(t, "quay.io/llamastack/distribution:v1", instance.Status.ResolvedDistribution.Image)
+	assert.Nil(t, GetCondition(&instance.Status, ConditionTypeDistributionChanged))
+	assert.Empty(t, recorder.Events)
+
+	// Image change fires an event and sets the condition.
+	r.recordDistributionChange(instance, "quay.io/llamastack/distribution:v2")
+	assert.Equal(t, "quay.io/llamastack/distribution:v2", instance.Status.ResolvedDistribution.Image)
+	require.True(t, IsConditionTrue(&instance.Status, ConditionTypeDistributionChanged))
+	condition := GetCondition(&instance.Status, ConditionTypeDistributionChanged)
+	assert.Contains(t, condition.Message, "quay.io/llamastack/distribution:v1 to quay.io/llamastack/distribution:v2")
+	require.Len(t, recorder.Events, 1)
+	assert.Contains(t, <-recorder.Events, ReasonDistributionChanged)
+
+	// A no-op reconcile clears the condition without another event.
+	r.recordDistributionChange(instance, "quay.io/llamastack/distribution:v2")
+	assert.True(t, IsConditionFalse(&instance.Status, ConditionTypeDistributionChanged))
+	assert.Empty(t, recorder.Events)
+}
+
+func TestDefaultDistribution(t *testing.T) {
+	scheme := runtime.NewScheme()
+	require.NoError(t, clientgoscheme.AddToScheme(scheme))
+	require.NoError(t, llamav1alpha1.AddToScheme(scheme))
+
+	tests := []struct {
+		name             string
+		distribution     llamav1alpha1.DistributionType
+		expected         llamav1alpha1.DistributionType
+		expectAnnotation bool
+	}{
+		{
+			name:             "empty distribution defaults to starter",
+			expected:         llamav1alpha1.DistributionType{Name: llamav1alpha1.DefaultDistributionName},
+			expectAnnotation: true,
+		},
+		{
+			name:         "explicit name is left untouched",
+			distribution: llamav1alpha1.DistributionType{Name: "ollama"},
+			expected:     llamav1alpha1.DistributionType{Name: "ollama"},
+		},
+		{
+			name:         "explicit image is left untouched",
+			distribution: llamav1alpha1.DistributionType{Image: "quay.io/custom/llama-stack:latest"},
+			expected:     llamav1alpha1.DistributionType{Image: "quay.io/custom/llama-stack:latest"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			instance := &llamav1alpha1.LlamaStackDistribution{
+				ObjectMeta: metav1.ObjectMeta{Name: "defaulted", Namespace: "default"},
+				Spec: llamav1alpha1.LlamaStackDistributionSpec{
+					Server: llamav1alpha1.ServerSpec{Distribution: tt.distribution},
+				},
+			}
+			r := &LlamaStackDistributionReconciler{
+				Client: fake.NewClientBuilder().WithScheme(scheme).WithObjects(instance).Build(),
+			}
+
+			require.NoError(t, r.defaultDistribution(t.Context(), instance))
+
+			// The default is persisted, not only applied in memory.
+			stored := &llamav1alpha1.LlamaStackDistribution{}
+			require.NoError(t, r.Get(t.Context(), client.ObjectKeyFromObject(instance), stored))
+			assert.Equal(t, tt.expected, stored.Spec.Server.Distribution)
+			if tt.expectAnnotation {
+				assert.Equal(t, llamav1alpha1.DefaultDistributionName,
+					stored.Annotations[llamav1alpha1.DefaultedDistributionAnnotationKey])
+			} else {
+				assert.NotContains(t, stored.Annotations, llamav1alpha1.DefaultedDistributionAnnotationKey)
+			}
+		})
+	}
+}
@@ -0,0 +1,96 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controllers
+
+import (
+	"testing"
+	"time"
+
+	llamav1alpha1 "github.com/llamastack/llama-stack-k8s-operator/api/v1alpha1"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	clocktesting "k8s.io/utils/clock/testing"
+)
+
+func newUnavailableInstance(gracePeriod *metav1.Duration) *llamav1alpha1.LlamaStackDistribution {
+	return &llamav1alpha1.LlamaStackDistribution{
+		Spec: llamav1alpha1.LlamaStackDistributionSpec{
+			Replicas:           1,
+			FailureGracePeriod: gracePeriod,
+		},
+		Status: llamav1alpha1.LlamaStackDistributionStatus{
+			Phase: llamav1alpha1.LlamaStackDistributionPhaseInitializing,
+		},
+	}
+}
+
+func TestApplyFailureGracePeriod_DefaultWindow(t *testing.T) {
+	start := time.Date(2025, 1, 1, 12, 0, 0, 0, time.UTC)
+	fakeClock := clocktesting.NewFakePassiveClock(start)
+	r := &LlamaStackDistributionReconciler{clock: fakeClock}
+	instance := newUnavailableInstance(nil)
+
+	// First observation records when the deployment became unavailable.
+	r.applyFailureGracePeriod(instance, true)
+	require.NotNil(t, instance.Status.UnavailableSince)
+	assert.True(t, instance.Status.UnavailableSince.Time.Equal(start))
+	assert.Equal(t, llamav1alpha1.LlamaStackDistributionPhaseInitializing, instance.Status.Phase)
+
+	// Still within the grace window.
+	fakeClock.SetTime(start.Add(llamav1alpha1.DefaultFailureGracePeriod.Duration - time.Second))
+	r.applyFailureGracePeriod(instance, true)
+	assert.Equal(t, llamav1alpha1.LlamaStackDistributionPhaseInitializing, instance.Status.Phase)
+	assert.True(t, instance.Status.UnavailableSince.Time.Equal(start))
+
+	// Grace window elapsed.
+	fakeClock.SetTime(start.Add(llamav1alpha1.DefaultFailureGracePeriod.Duration))
+	r.applyFailureGracePeriod(instance, true)
+	assert.Equal(t, llamav1alpha1.LlamaStackDistributionPhaseFailed, instance.Status.Phase)
+	assert.True(t, IsConditionFalse(&instance.Status, ConditionTypeDeploymentReady))
+}
+
+func TestApplyFailureGracePeriod_CustomWindow(t *testing.T) {
+	start := time.Date(2025, 1, 1, 12, 0, 0, 0, time.UTC)
+	fakeClock := clocktesting.NewFakePassiveClock(start)
+	r := &LlamaStackDistributionReconciler{clock: fakeClock}
+	instance := newUnavailableInstance(&metav1.Duration{Duration: 10 * time.Minute})
+
+	r.applyFailureGracePeriod(instance, true)
+
+	fakeClock.SetTime(start.Add(5 * time.Minute))
+	r.applyFailureGracePeriod(instance, true)
+	assert.Equal(t, llamav1alpha1.LlamaStackDistributionPhaseInitializing, instance.Status.Phase)
+
+	fakeClock.SetTime(start.Add(10 * time.Minute))
+	r.applyFailureGracePeriod(instance, true)
+	assert.Equal(t, llamav1alpha1.LlamaStackDistributionPhaseFailed, instance.Status.Phase)
+}
+
+func TestApplyFailureGracePeriod_ClearedWhenAvailable(t *testing.T) {
+	start := time.Date(2025, 1, 1, 12, 0, 0, 0, time.UTC)
+	r := &LlamaStackDistributionReconciler{clock: clocktesting.NewFakePassiveClock(start)}
+	instance := newUnavailableInstance(nil)
+	since := metav1.NewTime(start.Add(-time.Hour))
+	instance.Status.UnavailableSince = &since
+	instance.Status.Phase = llamav1alpha1.LlamaStackDistributionPhaseReady
+
+	r.applyFailureGracePeriod(instance, false)
+
+	assert.Nil(t, instance.Status.UnavailableSince)
+	assert.Equal(t, llamav1alpha1.LlamaStackDistributionPhaseReady, instance.Status.Phase)
+}
@@ -19,10 +19,12 @@ import (
 	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/apimachinery/pkg/util/intstr"
 	"k8s.io/client-go/kubernetes/scheme"
 	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/event"
 	"sigs.k8s.io/controller-runtime/pkg/log/zap"
 	"sigs.k8s.io/controller-runtime/pkg/reconcile"
@@ -270,7 +272,7 @@ func TestReconcile(t *testing.T) {
 	expectedPort := corev1.ServicePort{
 		Name:       llamav1alpha1.DefaultServicePortName,
 		Port:       instancePort,
-		TargetPort: intstr.FromInt(int(instancePort)),
+		TargetPort: intstr.FromString(llamav1alpha1.DefaultContainerPortName),
 		Protocol:   corev1.ProtocolTCP,
 	}
 	operatorNamespaceName := "test-operator-namespace"
@@ -323,6 +325,34 @@ func TestReconcile(t *testing.T) {
 	AssertResourceOwnedByInstance(t, serviceAccount, instance)
 }
 
+func TestServiceTargetsNamedContainerPort(t *testing.T) {
+	// --- arrange ---
+	namespace := createTestNamespace(t, "test-named-port")
+	instance := NewDistributionBuilder().
+		WithName("named-port").
+		WithNamespace(namespace.Name).
+		WithDistribution("starter").
+		WithPort(9000).
+		Build()
+	require.NoError(t, k8sClient.Create(t.Context(), instance))
+
+	// --- act ---
+	ReconcileDistribution(t, instance, false)
+
+	service := &corev1.Service{}
+	waitForResource(t, k8sClient, instance.Namespace, instance.Name+"-service", service)
+	deployment := &appsv1.Deployment{}
+	waitForResource(t, k8sClient, instance.Namespace, instance.Name, deployment)
+
+	// --- assert ---
+	AssertServiceTargetsNamedPort(t, service, deployment)
+	AssertServiceAndDeploymentPortsAlign(t, service, deployment)
+	probe := deployment.Spec.Template.Spec.Containers[0].StartupProbe
+	require.NotNil(t, probe)
+	require.Equal(t, intstr.FromString(llamav1alpha1.DefaultContainerPortName), probe.HTTPGet.Port,
+		"startup probe should target the container port by name")
+}
+
 // Define a custom roundtripper type for testing.
 type mockRoundTripper struct {
 	RoundTripFunc func(req *http.Request) (*http.Response, error)
@@ -860,6 +890,55 @@ onemore: registry.redhat.io/org/imagename@sha256:1234567890123456789012345678901
 	require.NotContains(t, result, "malformed", "Malformed entry should be skipped")
 }
 
+func TestParseDistributionImages(t *testing.T) {
+	ctrl.SetLogger(zap.New(zap.UseDevMode(true)))
+
+	configMapData := map[string]string{
+		"distributions.json": `{
+  "custom": "quay.io/custom/distribution:latest",
+  "invalid": "not a valid image reference!!!"
+}`,
+	}
+
+	result, err := controllers.ParseDistributionImages(t.Context(), configMapData)
+
+	require.NoError(t, err)
+	require.Len(t, result, 1, "Should skip the invalid image reference")
+	require.Equal(t, "quay.io/custom/distribution:latest", result["custom"])
+}
+
+func TestParseDistributionImages_InvalidJSON(t *testing.T) {
+	ctrl.SetLogger(zap.New(zap.UseDevMode(true)))
+
+	configMapData := map[string]string{
+		"distributions.json": "{not json",
+	}
+
+	result, err := controllers.ParseDistributionImages(t.Context(), configMapData)
+
+	require.Error(t, err)
+	require.Nil(t, result)
+}
+
+func TestParseDistributionHealthPaths(t *testing.T) {
+	ctrl.SetLogger(zap.New(zap.UseDevMode(true)))
+
+	configMapData := map[string]string{
+		"health-paths.json": `{
+  "custom": "/healthz",
+  "relative": "healthz"
+}`,
+	}
+
+	result, err := controllers.ParseDistributionHealthPaths(t.Context(), configMapData)
+
+	require.NoError(t, err)
+	require.Equal(t, map[string]string{"custom": "/healthz"}, result, "Should skip the relative path")
+
+	_, err = controllers.ParseDistributionHealthPaths(t.Context(), map[string]string{"health-paths.json": "{not json"})
+	require.Error(t, err)
+}
+
 func TestNewLlamaStackDistributionReconciler_WithImageOverrides(t *testing.T) {
 	ctrl.SetLogger(zap.New(zap.UseDevMode(true)))
 
@@ -1163,6 +1242,201 @@ func TestMapConfigMapToReconcileRequests(t *testing.T) {
 	}, requests[0])
 }
 
+func TestDistributionDefaulting(t *testing.T) {
+	namespace := createTestNamespace(t, "test-distribution-default")
+
+	tests := []struct {
+		name         string
+		server       map[string]any
+		expectedName string
+		expectedImg  string
+	}{
+		{
+			name:         "distribution omitted defaults to starter",
+			server:       map[string]any{},
+			expectedName: "starter",
+		},
+		{
+			name: "explicit image is left untouched",
+			server: map[string]any{
+				"distribution": map[string]any{"image": "quay.io/custom/llama-stack:latest"},
+			},
+			expectedImg: "quay.io/custom/llama-stack:latest",
+		},
+	}
+
+	for i, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			obj := &unstructured.Unstructured{Object: map[string]any{
+				"apiVersion": llamav1alpha1.GroupVersion.String(),
+				"kind":       "LlamaStackDistribution",
+				"metadata": map[string]any{
+					"name":      fmt.Sprintf("distribution-default-%d", i),
+					"namespace": namespace.Name,
+				},
+				"spec": map[string]any{
+					"server": tt.server,
+				},
+			}}
+			require.NoError(t, k8sClient.Create(t.Context(), obj))
+
+			instance := &llamav1alpha1.LlamaStackDistribution{}
+			require.NoError(t, k8sClient.Get(t.Context(), client.ObjectKeyFromObject(obj), instance))
+			require.Equal(t, tt.expectedName, instance.Spec.Server.Distribution.Name)
+			require.Equal(t, tt.expectedImg, instance.Spec.Server.Distribution.Image)
+		})
+	}
+
+	t.Run("typed client omitting distribution defaults to starter", func(t *testing.T) {
+		instance := &llamav1alpha1.LlamaStackDistribution{
+			ObjectMeta: metav1.ObjectMeta{Name: "distribution-default-typed", Namespace: namespace.Name},
+		}
+		require.NoError(t, k8sClient.Create(t.Context(), instance))
+		require.Equal(t, llamav1alpha1.DefaultDistributionName, instance.Spec.Server.Distribution.Name)
+	})
+}
+
+func TestReferencedSecretChangeTriggersReconcile(t *testing.T) {
+	ctrl.SetLogger(zap.New(zap.UseDevMode(true)))
+
+	namespace := createTestNamespace(t, "test-secret-mapping")
+
+	// Create a Secret with the watch label.
+	secret := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "provider-credentials",
+			Namespace: namespace.Name,
+			Labels: map[string]string{
+				controllers.WatchLabelKey: controllers.WatchLabelValue,
+			},
+		},
+		StringData: map[string]string{"api-key": "initial"},
+	}
+	require.NoError(t, k8sClient.Create(t.Context(), secret))
+
+	// Create an instance whose env references the Secret, and one that does not.
+	instance := NewDistributionBuilder().
+		WithName("test-secret-mapping").
+		WithNamespace(namespace.Name).
+		WithEnv(corev1.EnvVar{
+			Name: "PROVIDER_API_KEY",
+			ValueFrom: &corev1.EnvVarSource{
+				SecretKeyRef: &corev1.SecretKeySelector{
+					LocalObjectReference: corev1.LocalObjectReference{Name: secret.Name},
+					Key:                  "api-key",
+				},
+			},
+		}).
+		Build()
+	require.NoError(t, k8sClient.Create(t.Context(), instance))
+	t.Cleanup(func() { _ = k8sClient.Delete(t.Context(), instance) })
+
+	unrelated := NewDistributionBuilder().
+		WithName("test-secret-unrelated").
+		WithNamespace(namespace.Name).
+		Build()
+	require.NoError(t, k8sClient.Create(t.Context(), unrelated))
+	t.Cleanup(func() { _ = k8sClient.Delete(t.Context(), unrelated) })
+
+	ReconcileDistribution(t, instance, false)
+
+	deployment := &appsv1.Deployment{}
+	deploymentKey := types.NamespacedName{Name: instance.Name, Namespace: instance.Namespace}
+	waitForResourceWithKey(t, k8sClient, deploymentKey, deployment)
+	initialHash := deployment.Spec.Template.Annotations["secret.hash/env"]
+	require.NotEmpty(t, initialHash, "Secret hash annotation should be present")
+
+	// Rotate the Secret value.
+	require.NoError(t, k8sClient.Get(t.Context(), client.ObjectKeyFromObject(secret), secret))
+	secret.Data["api-key"] = []byte("rotated")
+	require.NoError(t, k8sClient.Update(t.Context(), secret))
+
+	// The handler should only enqueue the referencing instance.
+	reconciler := createTestReconciler()
+	requests := reconciler.MapSecretToReconcileRequests(t.Context(), secret)
+	require.Equal(t, []reconcile.Request{{
+		NamespacedName: types.NamespacedName{
+			Name:      instance.Name,
+			Namespace: instance.Namespace,
+		},
+	}}, requests)
+
+	// Reconciling again rolls the pods through a new hash.
+	ReconcileDistribution(t, instance, false)
+	waitForResourceWithKeyAndCondition(
+		t, k8sClient, deploymentKey, deployment, func() bool {
+			newHash := deployment.Spec.Template.Annotations["secret.hash/env"]
+			return newHash != initialHash && newHash != ""
+		}, "Secret hash should be updated after the Secret data change")
+}
+
+func TestNoOpReconcileLeavesResourcesUnchanged(t *testing.T) {
+	ctrl.SetLogger(zap.New(zap.UseDevMode(true)))
+
+	namespace := createTestNamespace(t, "test-noop-reconcile")
+
+	instance := NewDistributionBuilder().
+		WithName("test-noop").
+		WithNamespace(namespace.Name).
+		Build()
+	require.NoError(t, k8sClient.Create(t.Context(), instance))
+	t.Cleanup(func() { _ = k8sClient.Delete(t.Context(), instance) })
+
+	ReconcileDistribution(t, instance, false)
+
+	deployment := &appsv1.Deployment{}
+	deploymentKey := types.NamespacedName{Name: instance.Name, Namespace: instance.Namespace}
+	waitForResourceWithKey(t, k8sClient, deploymentKey, deployment)
+	service := &corev1.Service{}
+	serviceKey := types.NamespacedName{Name: instance.Name + "-service", Namespace: instance.Namespace}
+	waitForResourceWithKey(t, k8sClient, serviceKey, service)
+
+	// A reconcile without any change must not write the owned resources; the
+	// server-side apply patch is a no-op, so drift correction stays cheap.
+	ReconcileDistribution(t, instance, false)
+
+	unchangedDeployment := &appsv1.Deployment{}
+	require.NoError(t, k8sClient.Get(t.Context(), deploymentKey, unchangedDeployment))
+	require.Equal(t, deployment.ResourceVersion, unchangedDeployment.ResourceVersion)
+	unchangedService := &corev1.Service{}
+	require.NoError(t, k8sClient.Get(t.Context(), serviceKey, unchangedService))
+	require.Equal(t, service.ResourceVersion, unchangedService.ResourceVersion)
+}
+
+func TestRollAnnotationRollsDeployment(t *testing.T) {
+	ctrl.SetLogger(zap.New(zap.UseDevMode(true)))
+
+	namespace := createTestNamespace(t, "test-roll-annotation")
+
+	instance := NewDistributionBuilder().
+		WithName("test-roll").
+		WithNamespace(namespace.Name).
+		Build()
+	instance.Annotations = map[string]string{llamav1alpha1.RollAnnotationKey: "1"}
+	require.NoError(t, k8sClient.Create(t.Context(), instance))
+	t.Cleanup(func() { _ = k8sClient.Delete(t.Context(), instance) })
+
+	ReconcileDistribution(t, instance, false)
+
+	deployment := &appsv1.Deployment{}
+	deploymentKey := types.NamespacedName{Name: instance.Name, Namespace: instance.Namespace}
+	waitForResourceWithKey(t, k8sClient, deploymentKey, deployment)
+	require.Equal(t, "1", deployment.Spec.Template.Annotations[llamav1alpha1.RollAnnotationKey])
+	initialContainers := deployment.Spec.Template.Spec.Containers
+
+	// Bump only the roll annotation; the spec and config stay the same.
+	require.NoError(t, k8sClient.Get(t.Context(), client.ObjectKeyFromObject(instance), instance))
+	instance.Annotations[llamav1alpha1.RollAnnotationKey] = "2"
+	require.NoError(t, k8sClient.Update(t.Context(), instance))
+
+	ReconcileDistribution(t, instance, false)
+	waitForResourceWithKeyAndCondition(
+		t, k8sClient, deploymentKey, deployment, func() bool {
+			return deployment.Spec.Template.Annotations[llamav1alpha1.RollAnnotationKey] == "2"
+		}, "roll annotation should be copied to the pod template")
+	require.Equal(t, initialContainers, deployment.Spec.Template.Spec.Containers)
+}
+
 func TestMapConfigMapToReconcileRequests_SkipsManagedConfigMaps(t *testing.T) {
 	ctrl.SetLogger(zap.New(zap.UseDevMode(true)))
 
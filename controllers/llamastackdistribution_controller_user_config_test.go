@@ -0,0 +1,272 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controllers
+
+import (
+	"testing"
+
+	llamav1alpha1 "github.com/llamastack/llama-stack-k8s-operator/api/v1alpha1"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+func newCrossNamespaceConfigReconciler(t *testing.T, allowed []string) *LlamaStackDistributionReconciler {
+	t.Helper()
+	scheme := runtime.NewScheme()
+	require.NoError(t, clientgoscheme.AddToScheme(scheme))
+	require.NoError(t, llamav1alpha1.AddToScheme(scheme))
+
+	source := &corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{Name: "shared-config", Namespace: "config-ns"},
+		Data:       map[string]string{"config.yaml": "version: 2\n"},
+	}
+
+	return &LlamaStackDistributionReconciler{
+		Client:                     fake.NewClientBuilder().WithScheme(scheme).WithObjects(source).Build(),
+		Scheme:                     scheme,
+		AllowedConfigMapNamespaces: allowed,
+	}
+}
+
+func newCrossNamespaceConfigInstance() *llamav1alpha1.LlamaStackDistribution {
+	return &llamav1alpha1.LlamaStackDistribution{
+		ObjectMeta: metav1.ObjectMeta{Name: "cross-ns", Namespace: "app-ns", UID: "cross-ns-uid"},
+		Spec: llamav1alpha1.LlamaStackDistributionSpec{
+			Server: llamav1alpha1.ServerSpec{
+				UserConfig: &llamav1alpha1.UserConfigSpec{
+					ConfigMapName:      "shared-config",
+					ConfigMapNamespace: "config-ns",
+				},
+			},
+		},
+	}
+}
+
+func TestReconcileUserConfigMap_CrossNamespaceAllowed(t *testing.T) {
+	r := newCrossNamespaceConfigReconciler(t, []string{"config-ns"})
+	instance := newCrossNamespaceConfigInstance()
+
+	require.NoError(t, r.reconcileUserConfigMap(t.Context(), instance))
+
+	// The config is copied into the instance namespace as an owned ConfigMap.
+	copied := &corev1.ConfigMap{}
+	require.NoError(t, r.Get(t.Context(), types.NamespacedName{Name: "cross-ns-shared-config", Namespace: "app-ns"}, copied))
+	assert.Equal(t, "version: 2\n", copied.Data["config.yaml"])
+	assert.True(t, metav1.IsControlledBy(copied, instance))
+
+	// The pod mounts the copy rather than the unreachable source.
+	podSpec := corev1.PodSpec{}
+	configureUserConfig(instance, &podSpec)
+	require.Len(t, podSpec.Volumes, 1)
+	assert.Equal(t, "cross-ns-shared-config", podSpec.Volumes[0].ConfigMap.Name)
+}
+
+func TestReconcileUserConfigMap_RepairsDriftedCopy(t *testing.T) {
+	r := newCrossNamespaceConfigReconciler(t, []string{"config-ns"})
+	instance := newCrossNamespaceConfigInstance()
+	key := types.NamespacedName{Name: "cross-ns-shared-config", Namespace: "app-ns"}
+
+	require.NoError(t, r.reconcileUserConfigMap(t.Context(), instance))
+
+	// Simulate a manual edit that strips the owner reference and labels.
+	copied := &corev1.ConfigMap{}
+	require.NoError(t, r.Get(t.Context(), key, copied))
+	copied.OwnerReferences = nil
+	copied.Labels = nil
+	require.NoError(t, r.Update(t.Context(), copied))
+
+	require.NoError(t, r.reconcileUserConfigMap(t.Context(), instance))
+
+	repaired := &corev1.ConfigMap{}
+	require.NoError(t, r.Get(t.Context(), key, repaired))
+	assert.True(t, metav1.IsControlledBy(repaired, instance))
+	assert.Equal(t, WatchLabelValue, repaired.Labels[WatchLabelKey])
+	assert.Equal(t, "cross-ns", repaired.Labels["app.kubernetes.io/instance"])
+}
+
+func TestReconcileUserConfigMap_UnchangedCopyIsNotRewritten(t *testing.T) {
+	r := newCrossNamespaceConfigReconciler(t, []string{"config-ns"})
+	instance := newCrossNamespaceConfigInstance()
+	key := types.NamespacedName{Name: "cross-ns-shared-config", Namespace: "app-ns"}
+
+	require.NoError(t, r.reconcileUserConfigMap(t.Context(), instance))
+	copied := &corev1.ConfigMap{}
+	require.NoError(t, r.Get(t.Context(), key, copied))
+
+	require.NoError(t, r.reconcileUserConfigMap(t.Context(), instance))
+	unchanged := &corev1.ConfigMap{}
+	require.NoError(t, r.Get(t.Context(), key, unchanged))
+	assert.Equal(t, copied.ResourceVersion, unchanged.ResourceVersion)
+}
+
+func TestReconcileUserConfigMap_RemovesUnreferencedCopy(t *testing.T) {
+	r := newCrossNamespaceConfigReconciler(t, []string{"config-ns"})
+	instance := newCrossNamespaceConfigInstance()
+	renamed := &corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{Name: "shared-config-v2", Namespace: "config-ns"},
+		Data:       map[string]string{"config.yaml": "version: 2\n"},
+	}
+	require.NoError(t, r.Create(t.Context(), renamed))
+
+	require.NoError(t, r.reconcileUserAndCABundleConfigMaps(t.Context(), instance))
+
+	// Pointing the instance at another source replaces the copy.
+	instance.Spec.Server.UserConfig.ConfigMapName = renamed.Name
+	require.NoError(t, r.reconcileUserAndCABundleConfigMaps(t.Context(), instance))
+
+	err := r.Get(t.Context(), types.NamespacedName{Name: "cross-ns-shared-config", Namespace: "app-ns"}, &corev1.ConfigMap{})
+	assert.True(t, k8serrors.IsNotFound(err), "stale copy should be deleted")
+	require.NoError(t, r.Get(t.Context(), types.NamespacedName{Name: "cross-ns-shared-config-v2", Namespace: "app-ns"}, &corev1.ConfigMap{}))
+
+	// Removing the user config removes the last copy.
+	instance.Spec.Server.UserConfig = nil
+	require.NoError(t, r.reconcileUserAndCABundleConfigMaps(t.Context(), instance))
+	err = r.Get(t.Context(), types.NamespacedName{Name: "cross-ns-shared-config-v2", Namespace: "app-ns"}, &corev1.ConfigMap{})
+	assert.True(t, k8serrors.IsNotFound(err), "copy should be deleted once no config references it")
+}
+
+func TestReconcileUserConfigMap_CrossNamespaceRejected(t *testing.T) {
+	r := newCrossNamespaceConfigReconciler(t, []string{"other-ns"})
+	instance := newCrossNamespaceConfigInstance()
+
+	err := r.reconcileUserConfigMap(t.Context(), instance)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "namespace config-ns is not in the operator's allowed-configmap-namespaces")
+
+	copied := &corev1.ConfigMap{}
+	err = r.Get(t.Context(), types.NamespacedName{Name: "cross-ns-shared-config", Namespace: "app-ns"}, copied)
+	require.Error(t, err)
+}
+
+func TestValidateUserConfigData(t *testing.T) {
+	tests := []struct {
+		name            string
+		data            map[string]string
+		storageDisabled bool
+		expectedError   string
+	}{
+		{
+			name: "valid config",
+			data: map[string]string{"config.yaml": "version: '2'\nimage_name: starter\n"},
+		},
+		{
+			name:            "sqlite metadata store without storage",
+			data:            map[string]string{"config.yaml": "version: '2'\nmetadata_store:\n  type: sqlite\n  db_path: /.llama/registry.db\n"},
+			storageDisabled: true,
+			expectedError:   "storage.disabled requires a non-sqlite metadata store",
+		},
+		{
+			name:            "default metadata store without storage",
+			data:            map[string]string{"config.yaml": "version: '2'\nimage_name: starter\n"},
+			storageDisabled: true,
+			expectedError:   "storage.disabled requires a non-sqlite metadata store",
+		},
+		{
+			name:            "sqlite storage backend without storage",
+			data:            map[string]string{"config.yaml": "version: '2'\nstorage:\n  backends:\n    kv_default:\n      type: kv_sqlite\n  stores:\n    metadata:\n      backend: kv_default\n"},
+			storageDisabled: true,
+			expectedError:   "storage.disabled requires a non-sqlite metadata store",
+		},
+		{
+			name:            "postgres metadata store without storage",
+			data:            map[string]string{"config.yaml": "version: '2'\nmetadata_store:\n  type: postgres\n"},
+			storageDisabled: true,
+		},
+		{
+			name:            "postgres storage backend without storage",
+			data:            map[string]string{"config.yaml": "version: '2'\nstorage:\n  backends:\n    kv_default:\n      type: kv_postgres\n  stores:\n    metadata:\n      backend: kv_default\n"},
+			storageDisabled: true,
+		},
+		{
+			name: "sqlite metadata store with storage",
+			data: map[string]string{"config.yaml": "version: '2'\nmetadata_store:\n  type: sqlite\n"},
+		},
+		{
+			name:          "missing version",
+			data:          map[string]string{"config.yaml": "image_name: starter\n"},
+			expectedError: "missing version field",
+		},
+		{
+			name:          "malformed yaml",
+			data:          map[string]string{"config.yaml": "version: [2\n"},
+			expectedError: "failed to parse config.yaml",
+		},
+		{
+			name:          "missing config.yaml key",
+			data:          map[string]string{"run.yaml": "version: '2'\n"},
+			expectedError: "failed to find key 'config.yaml'",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			configMap := &corev1.ConfigMap{
+				ObjectMeta: metav1.ObjectMeta{Name: "user-config", Namespace: "default"},
+				Data:       tc.data,
+			}
+
+			err := validateUserConfigData(configMap, tc.storageDisabled)
+			if tc.expectedError == "" {
+				require.NoError(t, err)
+				return
+			}
+			require.Error(t, err)
+			assert.Contains(t, err.Error(), tc.expectedError)
+		})
+	}
+}
+
+func TestReconcileUserConfigMap_MissingVersion(t *testing.T) {
+	configMap := &corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{Name: "no-version", Namespace: "default"},
+		Data:       map[string]string{"config.yaml": "image_name: starter\n"},
+	}
+	instance := &llamav1alpha1.LlamaStackDistribution{
+		ObjectMeta: metav1.ObjectMeta{Name: "no-version", Namespace: "default"},
+		Spec: llamav1alpha1.LlamaStackDistributionSpec{
+			Server: llamav1alpha1.ServerSpec{
+				UserConfig: &llamav1alpha1.UserConfigSpec{ConfigMapName: "no-version"},
+			},
+		},
+	}
+	r := &LlamaStackDistributionReconciler{
+		Client: fake.NewClientBuilder().WithScheme(clientgoscheme.Scheme).WithObjects(configMap).Build(),
+	}
+
+	err := r.reconcileUserConfigMap(t.Context(), instance)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "ConfigMap default/no-version: missing version field")
+}
+
+func TestParseAllowedConfigMapNamespaces(t *testing.T) {
+	namespaces := ParseAllowedConfigMapNamespaces(t.Context(), map[string]string{
+		"allowed-configmap-namespaces": "- shared-configs\n- platform\n",
+	})
+	assert.Equal(t, []string{"shared-configs", "platform"}, namespaces)
+
+	assert.Nil(t, ParseAllowedConfigMapNamespaces(t.Context(), map[string]string{}))
+	assert.Nil(t, ParseAllowedConfigMapNamespaces(t.Context(), map[string]string{
+		"allowed-configmap-namespaces": "not: a list",
+	}))
+}
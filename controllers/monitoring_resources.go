@@ -0,0 +1,175 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controllers
+
+import (
+	"context"
+	"fmt"
+	"maps"
+
+	llamav1alpha1 "github.com/llamastack/llama-stack-k8s-operator/api/v1alpha1"
+	"k8s.io/apimachinery/pkg/api/equality"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+)
+
+const (
+	// ServiceMonitorNameSuffix is the suffix for the ServiceMonitor name.
+	ServiceMonitorNameSuffix = "-monitor"
+	// serviceMonitorPortName is the name of the server port in the base Service manifest.
+	serviceMonitorPortName = "http"
+)
+
+// serviceMonitorGVK identifies the Prometheus Operator ServiceMonitor kind. The operator does
+// not depend on the Prometheus Operator types, so the ServiceMonitor is handled as unstructured.
+var serviceMonitorGVK = schema.GroupVersionKind{
+	Group:   "monitoring.coreos.com",
+	Version: "v1",
+	Kind:    "ServiceMonitor",
+}
+
+// hasServiceMonitor checks if the instance asks for a ServiceMonitor.
+func hasServiceMonitor(instance *llamav1alpha1.LlamaStackDistribution) bool {
+	return instance.Spec.Monitoring != nil &&
+		instance.Spec.Monitoring.ServiceMonitor != nil &&
+		instance.Spec.Monitoring.ServiceMonitor.Enabled &&
+		instance.HasPorts()
+}
+
+// serviceMonitorAvailable checks whether the ServiceMonitor CRD is installed.
+func (r *LlamaStackDistributionReconciler) serviceMonitorAvailable() (bool, error) {
+	_, err := r.RESTMapper().RESTMapping(serviceMonitorGVK.GroupKind(), serviceMonitorGVK.Version)
+	if err != nil {
+		if meta.IsNoMatchError(err) {
+			return false, nil
+		}
+		return false, fmt.Errorf("failed to look up ServiceMonitor kind: %w", err)
+	}
+	return true, nil
+}
+
+// reconcileServiceMonitor creates, updates, or deletes the ServiceMonitor based on the
+// monitoring settings. Nothing is done when the Prometheus Operator is not installed.
+func (r *LlamaStackDistributionReconciler) reconcileServiceMonitor(ctx context.Context, instance *llamav1alpha1.LlamaStackDistribution) error {
+	logger := log.FromContext(ctx)
+
+	available, err := r.serviceMonitorAvailable()
+	if err != nil {
+		return err
+	}
+	if !available {
+		if hasServiceMonitor(instance) {
+			logger.Info("ServiceMonitor requested but the monitoring.coreos.com CRDs are not installed, skipping")
+		}
+		return nil
+	}
+
+	name := instance.Name + ServiceMonitorNameSuffix
+	existing := &unstructured.Unstructured{}
+	existing.SetGroupVersionKind(serviceMonitorGVK)
+	err = r.directGet(ctx, types.NamespacedName{Name: name, Namespace: instance.Namespace}, existing)
+	if err != nil && !k8serrors.IsNotFound(err) {
+		return fmt.Errorf("failed to get ServiceMonitor: %w", err)
+	}
+	existsAlready := err == nil
+
+	if !hasServiceMonitor(instance) {
+		if !existsAlready || !metav1.IsControlledBy(existing, instance) {
+			return nil
+		}
+		logger.Info("Deleting ServiceMonitor as monitoring is disabled", "name", name)
+		if err := r.Delete(ctx, existing); err != nil && !k8serrors.IsNotFound(err) {
+			return fmt.Errorf("failed to delete ServiceMonitor: %w", err)
+		}
+		return nil
+	}
+
+	serviceMonitor, err := r.buildServiceMonitor(instance)
+	if err != nil {
+		return err
+	}
+
+	if !existsAlready {
+		logger.Info("Creating ServiceMonitor", "name", name)
+		if err := r.Create(ctx, serviceMonitor); err != nil {
+			return fmt.Errorf("failed to create ServiceMonitor: %w", err)
+		}
+		return nil
+	}
+
+	if !metav1.IsControlledBy(existing, instance) {
+		logger.V(1).Info("ServiceMonitor not owned by this instance, skipping update", "name", name)
+		return nil
+	}
+
+	if equality.Semantic.DeepEqual(existing.Object["spec"], serviceMonitor.Object["spec"]) &&
+		maps.Equal(existing.GetLabels(), serviceMonitor.GetLabels()) {
+		return nil
+	}
+
+	logger.Info("Updating ServiceMonitor", "name", name)
+	serviceMonitor.SetResourceVersion(existing.GetResourceVersion())
+	if err := r.Update(ctx, serviceMonitor); err != nil {
+		return fmt.Errorf("failed to update ServiceMonitor: %w", err)
+	}
+	return nil
+}
+
+// buildServiceMonitor creates a ServiceMonitor that scrapes the server Service.
+func (r *LlamaStackDistributionReconciler) buildServiceMonitor(instance *llamav1alpha1.LlamaStackDistribution) (*unstructured.Unstructured, error) {
+	spec := instance.Spec.Monitoring.ServiceMonitor
+
+	endpoint := map[string]any{
+		"port": serviceMonitorPortName,
+	}
+	if spec.Path != "" {
+		endpoint["path"] = spec.Path
+	}
+	if spec.Interval != "" {
+		endpoint["interval"] = spec.Interval
+	}
+
+	serviceMonitor := &unstructured.Unstructured{}
+	serviceMonitor.SetGroupVersionKind(serviceMonitorGVK)
+	serviceMonitor.SetName(instance.Name + ServiceMonitorNameSuffix)
+	serviceMonitor.SetNamespace(instance.Namespace)
+	serviceMonitor.SetLabels(map[string]string{
+		"app.kubernetes.io/managed-by": "llama-stack-operator",
+		"app.kubernetes.io/instance":   instance.Name,
+	})
+	serviceMonitor.Object["spec"] = map[string]any{
+		"selector": map[string]any{
+			"matchLabels": map[string]any{
+				"app.kubernetes.io/managed-by": "llama-stack-operator",
+				"app.kubernetes.io/instance":   instance.Name,
+			},
+		},
+		"endpoints": []any{endpoint},
+	}
+
+	if err := ctrl.SetControllerReference(instance, serviceMonitor, r.Scheme); err != nil {
+		return nil, fmt.Errorf("failed to set controller reference: %w", err)
+	}
+
+	return serviceMonitor, nil
+}
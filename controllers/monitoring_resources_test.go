@@ -0,0 +1,136 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controllers
+
+import (
+	"testing"
+
+	llamav1alpha1 "github.com/llamastack/llama-stack-k8s-operator/api/v1alpha1"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+func newMonitoringTestReconciler(t *testing.T, withServiceMonitorCRD bool) *LlamaStackDistributionReconciler {
+	t.Helper()
+	scheme := runtime.NewScheme()
+	require.NoError(t, clientgoscheme.AddToScheme(scheme))
+	require.NoError(t, llamav1alpha1.AddToScheme(scheme))
+
+	// The REST mapper stands in for API discovery of the Prometheus Operator CRDs.
+	mapper := meta.NewDefaultRESTMapper(nil)
+	if withServiceMonitorCRD {
+		mapper.Add(serviceMonitorGVK, meta.RESTScopeNamespace)
+	}
+
+	return &LlamaStackDistributionReconciler{
+		Client: fake.NewClientBuilder().WithScheme(scheme).WithRESTMapper(mapper).Build(),
+		Scheme: scheme,
+	}
+}
+
+func newMonitoredInstance(enabled bool) *llamav1alpha1.LlamaStackDistribution {
+	return &llamav1alpha1.LlamaStackDistribution{
+		ObjectMeta: metav1.ObjectMeta{Name: "monitored", Namespace: "default", UID: "monitored-uid"},
+		Spec: llamav1alpha1.LlamaStackDistributionSpec{
+			Server: llamav1alpha1.ServerSpec{
+				ContainerSpec: llamav1alpha1.ContainerSpec{Port: llamav1alpha1.DefaultServerPort},
+			},
+			Monitoring: &llamav1alpha1.MonitoringSpec{
+				ServiceMonitor: &llamav1alpha1.ServiceMonitorSpec{
+					Enabled:  enabled,
+					Interval: "15s",
+					Path:     "/v1/metrics",
+				},
+			},
+		},
+	}
+}
+
+func getServiceMonitor(t *testing.T, r *LlamaStackDistributionReconciler) (*unstructured.Unstructured, error) {
+	t.Helper()
+	serviceMonitor := &unstructured.Unstructured{}
+	serviceMonitor.SetGroupVersionKind(serviceMonitorGVK)
+	err := r.Get(t.Context(), types.NamespacedName{Name: "monitored-monitor", Namespace: "default"}, serviceMonitor)
+	return serviceMonitor, err
+}
+
+func TestReconcileServiceMonitor_CreatedWhenCRDPresent(t *testing.T) {
+	r := newMonitoringTestReconciler(t, true)
+	instance := newMonitoredInstance(true)
+
+	require.NoError(t, r.reconcileServiceMonitor(t.Context(), instance))
+
+	serviceMonitor, err := getServiceMonitor(t, r)
+	require.NoError(t, err)
+	assert.True(t, metav1.IsControlledBy(serviceMonitor, instance))
+
+	matchLabels, _, err := unstructured.NestedStringMap(serviceMonitor.Object, "spec", "selector", "matchLabels")
+	require.NoError(t, err)
+	assert.Equal(t, "monitored", matchLabels["app.kubernetes.io/instance"])
+
+	endpoints, _, err := unstructured.NestedSlice(serviceMonitor.Object, "spec", "endpoints")
+	require.NoError(t, err)
+	require.Len(t, endpoints, 1)
+	endpoint, ok := endpoints[0].(map[string]any)
+	require.True(t, ok)
+	assert.Equal(t, "http", endpoint["port"])
+	assert.Equal(t, "15s", endpoint["interval"])
+	assert.Equal(t, "/v1/metrics", endpoint["path"])
+
+	// An unchanged ServiceMonitor is not written again.
+	require.NoError(t, r.reconcileServiceMonitor(t.Context(), instance))
+	unchanged, err := getServiceMonitor(t, r)
+	require.NoError(t, err)
+	assert.Equal(t, serviceMonitor.GetResourceVersion(), unchanged.GetResourceVersion())
+
+	// A changed spec is updated.
+	instance.Spec.Monitoring.ServiceMonitor.Interval = "1m"
+	require.NoError(t, r.reconcileServiceMonitor(t.Context(), instance))
+	updated, err := getServiceMonitor(t, r)
+	require.NoError(t, err)
+	assert.NotEqual(t, serviceMonitor.GetResourceVersion(), updated.GetResourceVersion())
+	updatedEndpoints, _, err := unstructured.NestedSlice(updated.Object, "spec", "endpoints")
+	require.NoError(t, err)
+	require.Len(t, updatedEndpoints, 1)
+	assert.Equal(t, "1m", updatedEndpoints[0].(map[string]any)["interval"])
+
+	// Disabling monitoring removes the ServiceMonitor.
+	instance.Spec.Monitoring.ServiceMonitor.Enabled = false
+	require.NoError(t, r.reconcileServiceMonitor(t.Context(), instance))
+	_, err = getServiceMonitor(t, r)
+	assert.True(t, k8serrors.IsNotFound(err))
+}
+
+func TestReconcileServiceMonitor_SkippedWithoutCRD(t *testing.T) {
+	r := newMonitoringTestReconciler(t, false)
+	instance := newMonitoredInstance(true)
+
+	available, err := r.serviceMonitorAvailable()
+	require.NoError(t, err)
+	assert.False(t, available)
+
+	// A missing CRD is not an error; the ServiceMonitor is simply not created.
+	require.NoError(t, r.reconcileServiceMonitor(t.Context(), instance))
+}
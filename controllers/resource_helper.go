@@ -20,6 +20,8 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"maps"
+	"net"
 	"regexp"
 	"strconv"
 	"strings"
@@ -44,6 +46,8 @@ const (
 	FSGroup = int64(1001)
 	// instanceLabelKey is the label we apply to all resources for per-instance targeting.
 	instanceLabelKey = "app.kubernetes.io/instance"
+	// defaultDrainPath is the server endpoint the preStop hook calls to stop accepting requests.
+	defaultDrainPath = "/v1/drain"
 )
 
 var (
@@ -55,7 +59,8 @@ var (
 const (
 	startupProbeInitialDelaySeconds = 15 // Time to wait before the first probe
 	startupProbeTimeoutSeconds      = 30 // When the probe times out
-	startupProbeFailureThreshold    = 3  // Pod is marked Unhealthy after 3 consecutive failures
+	startupProbePeriodSeconds       = 10 // How often the probe runs
+	startupProbeFailureThreshold    = 30 // Allows about 5 minutes for providers and models to load
 	startupProbeSuccessThreshold    = 1  // Pod is marked Ready after 1 successful probe
 )
 
@@ -101,18 +106,28 @@ except Exception as e:
     print(2)
 ")
 
+HOST=${LLS_HOST:-0.0.0.0}
 PORT=${LLS_PORT:-8321}
 WORKERS=${LLS_WORKERS:-1}
-
-# Execute the appropriate CLI based on version
+LOG_LEVEL=${LLS_LOG_LEVEL:-info}
+CONFIG_PATH=${LLAMA_STACK_CONFIG:-/etc/llama-stack/config.yaml}
+ACCESS_LOG_FLAG="--access-log"
+if [ "${LLS_ACCESS_LOG:-true}" = "false" ]; then
+    ACCESS_LOG_FLAG="--no-access-log"
+fi
+
+# Execute the appropriate CLI based on version, forwarding any container args
 case $VERSION_CODE in
-    0) python3 -m llama_stack.distribution.server.server --config /etc/llama-stack/config.yaml ;;
-    1) python3 -m llama_stack.core.server.server /etc/llama-stack/config.yaml ;;
-    2) exec uvicorn llama_stack.core.server.server:create_app --host 0.0.0.0 --port "$PORT" --workers "$WORKERS" --factory ;;
+    0) python3 -m llama_stack.distribution.server.server --config "$CONFIG_PATH" ${LLS_HOST:+--host "$HOST"} "$@" ;;
+    1) python3 -m llama_stack.core.server.server "$CONFIG_PATH" ${LLS_HOST:+--host "$HOST"} "$@" ;;
+    2) exec uvicorn llama_stack.core.server.server:create_app --host "$HOST" --port "$PORT" --workers "$WORKERS" --log-level "$LOG_LEVEL" "$ACCESS_LOG_FLAG" --factory "$@" ;;
     *) echo "Invalid version code: $VERSION_CODE, using uvicorn CLI command"; \
-       exec uvicorn llama_stack.core.server.server:create_app --host 0.0.0.0 --port "$PORT" --workers "$WORKERS" --factory ;;
+       exec uvicorn llama_stack.core.server.server:create_app --host "$HOST" --port "$PORT" --workers "$WORKERS" --log-level "$LOG_LEVEL" "$ACCESS_LOG_FLAG" --factory "$@" ;;
 esac`
 
+// startupScriptName fills $0 for the startup script so container args land in "$@".
+const startupScriptName = "llama-stack"
+
 const llamaStackConfigPath = "/etc/llama-stack/config.yaml"
 
 // validateConfigMapKeys validates that all ConfigMap keys contain only safe characters.
@@ -138,25 +153,52 @@ func validateConfigMapKeys(keys []string) error {
 	return nil
 }
 
+// defaultHealthPath is the health endpoint served by llama-stack distributions.
+const defaultHealthPath = "/v1/health"
+
+// getHealthPath returns the health probe path, preferring the spec override, then the
+// path registered for the distribution in healthPaths.
+func getHealthPath(instance *llamav1alpha1.LlamaStackDistribution, healthPaths map[string]string) string {
+	if instance.Spec.Server.HealthPath != "" {
+		return instance.Spec.Server.HealthPath
+	}
+	if path, ok := healthPaths[instance.Spec.Server.Distribution.Name]; ok {
+		return path
+	}
+	return defaultHealthPath
+}
+
 // getHealthProbe returns the health probe handler for the container.
-func getHealthProbe(instance *llamav1alpha1.LlamaStackDistribution) corev1.ProbeHandler {
+func getHealthProbe(instance *llamav1alpha1.LlamaStackDistribution, healthPaths map[string]string) corev1.ProbeHandler {
 	return corev1.ProbeHandler{
 		HTTPGet: &corev1.HTTPGetAction{
-			Path: "/v1/health",
-			Port: intstr.FromInt(int(getContainerPort(instance))),
+			Path: getHealthPath(instance, healthPaths),
+			Port: intstr.FromString(llamav1alpha1.DefaultContainerPortName),
 		},
 	}
 }
 
 // getStartupProbe returns the startup probe for the container.
-func getStartupProbe(instance *llamav1alpha1.LlamaStackDistribution) *corev1.Probe {
-	return &corev1.Probe{
-		ProbeHandler:        getHealthProbe(instance),
+func getStartupProbe(instance *llamav1alpha1.LlamaStackDistribution, healthPaths map[string]string) *corev1.Probe {
+	probe := &corev1.Probe{
+		ProbeHandler:        getHealthProbe(instance, healthPaths),
 		InitialDelaySeconds: startupProbeInitialDelaySeconds,
 		TimeoutSeconds:      startupProbeTimeoutSeconds,
+		PeriodSeconds:       startupProbePeriodSeconds,
 		FailureThreshold:    startupProbeFailureThreshold,
 		SuccessThreshold:    startupProbeSuccessThreshold,
 	}
+
+	if tuning := instance.Spec.Server.StartupProbe; tuning != nil {
+		if tuning.FailureThreshold != nil {
+			probe.FailureThreshold = *tuning.FailureThreshold
+		}
+		if tuning.PeriodSeconds != nil {
+			probe.PeriodSeconds = *tuning.PeriodSeconds
+		}
+	}
+
+	return probe
 }
 
 // buildContainerSpec creates the container specification.
@@ -167,8 +209,16 @@ func buildContainerSpec(ctx context.Context, r *LlamaStackDistributionReconciler
 		Name:         getContainerName(instance),
 		Image:        image,
 		Resources:    resolveContainerResources(instance.Spec.Server.ContainerSpec, workers, workersSet),
-		Ports:        []corev1.ContainerPort{{ContainerPort: getContainerPort(instance)}},
-		StartupProbe: getStartupProbe(instance),
+		Ports:        []corev1.ContainerPort{{Name: llamav1alpha1.DefaultContainerPortName, ContainerPort: getContainerPort(instance)}},
+		StartupProbe: getStartupProbe(instance, r.distributionHealthPaths()),
+		Lifecycle:    getLifecycle(instance),
+		WorkingDir:   instance.Spec.Server.ContainerSpec.WorkingDir,
+	}
+
+	if runAsUser := instance.Spec.Server.ContainerSpec.RunAsUser; runAsUser != nil {
+		container.SecurityContext = &corev1.SecurityContext{
+			RunAsUser: runAsUser,
+		}
 	}
 
 	// Configure environment variables and mounts
@@ -182,12 +232,13 @@ func buildContainerSpec(ctx context.Context, r *LlamaStackDistributionReconciler
 // resolveContainerResources ensures the container always has CPU and memory
 // requests defined so that HPAs using utilization metrics can function.
 func resolveContainerResources(spec llamav1alpha1.ContainerSpec, workers int32, workersSet bool) corev1.ResourceRequirements {
-	resources := spec.Resources
+	resources := *spec.Resources.DeepCopy()
 
 	ensureRequests(&resources, workers)
 	if workersSet {
 		ensureLimitsMatchRequests(&resources)
 	}
+	ensureGPUResources(&resources, spec.GPU)
 
 	cpuReq := resources.Requests[corev1.ResourceCPU]
 	memReq := resources.Requests[corev1.ResourceMemory]
@@ -222,6 +273,32 @@ func ensureRequests(resources *corev1.ResourceRequirements, workers int32) {
 	}
 }
 
+// ensureGPUResources adds the GPU extended resource to requests and limits, which
+// must be equal for extended resources. Explicit user entries are left untouched.
+func ensureGPUResources(resources *corev1.ResourceRequirements, gpu *llamav1alpha1.GPUSpec) {
+	if gpu == nil || gpu.Count <= 0 {
+		return
+	}
+
+	name := corev1.ResourceName(gpu.ResourceName)
+	if name == "" {
+		name = llamav1alpha1.DefaultGPUResourceName
+	}
+	if _, ok := resources.Limits[name]; ok {
+		return
+	}
+	if _, ok := resources.Requests[name]; ok {
+		return
+	}
+
+	count := *resource.NewQuantity(int64(gpu.Count), resource.DecimalSI)
+	if resources.Limits == nil {
+		resources.Limits = corev1.ResourceList{}
+	}
+	resources.Limits[name] = count
+	resources.Requests[name] = count
+}
+
 func ensureLimitsMatchRequests(resources *corev1.ResourceRequirements) {
 	if resources.Limits == nil {
 		resources.Limits = corev1.ResourceList{}
@@ -269,10 +346,12 @@ func configureContainerEnvironment(ctx context.Context, r *LlamaStackDistributio
 	// on the same volume as the storage. This is not critical but useful if the server is
 	// restarted so the models and datasets are not lost and need to be downloaded again.
 	// For more information, see https://huggingface.co/docs/datasets/en/cache
-	container.Env = append(container.Env, corev1.EnvVar{
-		Name:  "HF_HOME",
-		Value: mountPath,
-	})
+	if !instance.IsStorageDisabled() {
+		container.Env = append(container.Env, corev1.EnvVar{
+			Name:  "HF_HOME",
+			Value: mountPath,
+		})
+	}
 
 	// Add CA bundle environment variable if any CA bundles are configured
 	// (explicit or auto-detected ODH bundles)
@@ -296,14 +375,86 @@ func configureContainerEnvironment(ctx context.Context, r *LlamaStackDistributio
 		},
 		corev1.EnvVar{
 			Name:  "LLAMA_STACK_CONFIG",
-			Value: llamaStackConfigPath,
+			Value: getConfigMountPath(instance),
 		},
 	)
 
+	if bindAddress := getBindAddress(instance); bindAddress != "" {
+		container.Env = append(container.Env, corev1.EnvVar{
+			Name:  "LLS_HOST",
+			Value: bindAddress,
+		})
+	}
+
+	configureLoggingEnvironment(instance, container)
+	configureProxyEnvironment(instance, container)
+	configureDownwardEnvironment(instance, container)
+	configureThreadEnvironment(instance, container)
+
 	// Finally, add the user provided env vars
 	container.Env = append(container.Env, instance.Spec.Server.ContainerSpec.Env...)
 }
 
+// configureLoggingEnvironment exposes the log level and access log settings to the server.
+// LLAMA_STACK_LOGGING is read by llama-stack itself, the LLS_* variables are consumed by the
+// startup script when building the uvicorn command line. Variables the user already sets in
+// containerSpec.env are left to the user value.
+func configureLoggingEnvironment(instance *llamav1alpha1.LlamaStackDistribution, container *corev1.Container) {
+	var envVars []corev1.EnvVar
+	if level := instance.Spec.Server.LogLevel; level != "" {
+		envVars = append(envVars,
+			corev1.EnvVar{Name: "LLAMA_STACK_LOGGING", Value: "all=" + level},
+			corev1.EnvVar{Name: "LLS_LOG_LEVEL", Value: level},
+		)
+	}
+	if instance.Spec.Server.AccessLog != nil {
+		envVars = append(envVars, corev1.EnvVar{
+			Name:  "LLS_ACCESS_LOG",
+			Value: strconv.FormatBool(*instance.Spec.Server.AccessLog),
+		})
+	}
+
+	userEnv := make(map[string]struct{}, len(instance.Spec.Server.ContainerSpec.Env))
+	for _, env := range instance.Spec.Server.ContainerSpec.Env {
+		userEnv[env.Name] = struct{}{}
+	}
+
+	for _, env := range envVars {
+		if _, overridden := userEnv[env.Name]; overridden {
+			continue
+		}
+		container.Env = append(container.Env, env)
+	}
+}
+
+// configureProxyEnvironment exposes the egress proxy settings to the server.
+// Variables the user already sets in containerSpec.env are left to the user value.
+func configureProxyEnvironment(instance *llamav1alpha1.LlamaStackDistribution, container *corev1.Container) {
+	proxy := instance.Spec.Server.Proxy
+	if proxy == nil {
+		return
+	}
+
+	userEnv := make(map[string]struct{}, len(instance.Spec.Server.ContainerSpec.Env))
+	for _, env := range instance.Spec.Server.ContainerSpec.Env {
+		userEnv[env.Name] = struct{}{}
+	}
+
+	for _, env := range []corev1.EnvVar{
+		{Name: "HTTP_PROXY", Value: proxy.HTTPProxy},
+		{Name: "HTTPS_PROXY", Value: proxy.HTTPSProxy},
+		{Name: "NO_PROXY", Value: proxy.NoProxy},
+	} {
+		if env.Value == "" {
+			continue
+		}
+		if _, overridden := userEnv[env.Name]; overridden {
+			continue
+		}
+		container.Env = append(container.Env, env)
+	}
+}
+
 // configureContainerMounts sets up volume mounts for the container.
 func configureContainerMounts(ctx context.Context, r *LlamaStackDistributionReconciler, instance *llamav1alpha1.LlamaStackDistribution, container *corev1.Container) {
 	// Add volume mount for storage
@@ -319,7 +470,7 @@ func configureContainerMounts(ctx context.Context, r *LlamaStackDistributionReco
 // hasAnyCABundle checks if any CA bundle will be mounted (explicit or auto-detected).
 func hasAnyCABundle(ctx context.Context, r *LlamaStackDistributionReconciler, instance *llamav1alpha1.LlamaStackDistribution) bool {
 	// Check for explicit CA bundle configuration
-	if instance.Spec.Server.TLSConfig != nil && instance.Spec.Server.TLSConfig.CABundle != nil {
+	if len(getCABundleConfigs(instance)) > 0 {
 		return true
 	}
 
@@ -333,6 +484,13 @@ func hasAnyCABundle(ctx context.Context, r *LlamaStackDistributionReconciler, in
 	return false
 }
 
+// usesStartupScript checks if the server is started by the operator's startup script,
+// which is what applies the LLS_* settings. A custom command replaces the script.
+func usesStartupScript(instance *llamav1alpha1.LlamaStackDistribution) bool {
+	return instance.Spec.Server.UserConfig != nil && instance.Spec.Server.UserConfig.ConfigMapName != "" &&
+		len(instance.Spec.Server.ContainerSpec.Command) == 0
+}
+
 // configureContainerCommands sets up container commands and args.
 func configureContainerCommands(instance *llamav1alpha1.LlamaStackDistribution, container *corev1.Container) {
 	// Override the container entrypoint to use the custom config file if user config is specified
@@ -352,6 +510,67 @@ func configureContainerCommands(instance *llamav1alpha1.LlamaStackDistribution,
 
 	if len(instance.Spec.Server.ContainerSpec.Args) > 0 {
 		container.Args = instance.Spec.Server.ContainerSpec.Args
+	} else if len(instance.Spec.Server.ContainerSpec.ExtraArgs) > 0 {
+		args := append([]string{}, container.Args...)
+		// "sh -c" binds the first arg to $0, so name the script before the extra args
+		if usesStartupScript(instance) {
+			args = append(args, startupScriptName)
+		}
+		container.Args = append(args, instance.Spec.Server.ContainerSpec.ExtraArgs...)
+	}
+}
+
+// configureDownwardEnvironment exposes the pod identity to the server through the downward API.
+func configureDownwardEnvironment(instance *llamav1alpha1.LlamaStackDistribution, container *corev1.Container) {
+	if !instance.Spec.Server.ContainerSpec.InjectDownwardEnv {
+		return
+	}
+
+	userEnv := make(map[string]struct{}, len(instance.Spec.Server.ContainerSpec.Env))
+	for _, env := range instance.Spec.Server.ContainerSpec.Env {
+		userEnv[env.Name] = struct{}{}
+	}
+
+	for _, field := range []struct{ name, path string }{
+		{name: "POD_NAME", path: "metadata.name"},
+		{name: "POD_NAMESPACE", path: "metadata.namespace"},
+		{name: "POD_IP", path: "status.podIP"},
+		{name: "NODE_NAME", path: "spec.nodeName"},
+	} {
+		if _, overridden := userEnv[field.name]; overridden {
+			continue
+		}
+		container.Env = append(container.Env, corev1.EnvVar{
+			Name: field.name,
+			ValueFrom: &corev1.EnvVarSource{
+				FieldRef: &corev1.ObjectFieldSelector{FieldPath: field.path},
+			},
+		})
+	}
+}
+
+// configureThreadEnvironment sizes the server thread pools to the container CPU limit.
+func configureThreadEnvironment(instance *llamav1alpha1.LlamaStackDistribution, container *corev1.Container) {
+	if !instance.Spec.Server.ContainerSpec.AutoTuneThreads {
+		return
+	}
+	cpuLimit, ok := container.Resources.Limits[corev1.ResourceCPU]
+	if !ok || cpuLimit.IsZero() {
+		return
+	}
+
+	userEnv := make(map[string]struct{}, len(instance.Spec.Server.ContainerSpec.Env))
+	for _, env := range instance.Spec.Server.ContainerSpec.Env {
+		userEnv[env.Name] = struct{}{}
+	}
+
+	// Round fractional limits up so at least one thread is always available.
+	threads := strconv.FormatInt((cpuLimit.MilliValue()+999)/1000, 10)
+	for _, name := range []string{"OMP_NUM_THREADS", "GOMAXPROCS"} {
+		if _, overridden := userEnv[name]; overridden {
+			continue
+		}
+		container.Env = append(container.Env, corev1.EnvVar{Name: name, Value: threads})
 	}
 }
 
@@ -365,6 +584,10 @@ func getMountPath(instance *llamav1alpha1.LlamaStackDistribution) string {
 
 // addStorageVolumeMount adds the storage volume mount to the container.
 func addStorageVolumeMount(instance *llamav1alpha1.LlamaStackDistribution, container *corev1.Container) {
+	if instance.IsStorageDisabled() {
+		return
+	}
+
 	mountPath := getMountPath(instance)
 	container.VolumeMounts = append(container.VolumeMounts, corev1.VolumeMount{
 		Name:      "lls-storage",
@@ -375,6 +598,17 @@ func addStorageVolumeMount(instance *llamav1alpha1.LlamaStackDistribution, conta
 // addUserConfigVolumeMount adds the user config volume mount to the container if specified.
 func addUserConfigVolumeMount(instance *llamav1alpha1.LlamaStackDistribution, container *corev1.Container) {
 	if instance.Spec.Server.UserConfig != nil && instance.Spec.Server.UserConfig.ConfigMapName != "" {
+		if instance.Spec.Server.ConfigMountPath != "" {
+			// Mount only the config file so that the rest of the target directory
+			// in the image stays visible.
+			container.VolumeMounts = append(container.VolumeMounts, corev1.VolumeMount{
+				Name:      "user-config",
+				MountPath: instance.Spec.Server.ConfigMountPath,
+				SubPath:   "config.yaml",
+				ReadOnly:  true,
+			})
+			return
+		}
 		container.VolumeMounts = append(container.VolumeMounts, corev1.VolumeMount{
 			Name:      "user-config",
 			MountPath: "/etc/llama-stack/",
@@ -383,6 +617,14 @@ func addUserConfigVolumeMount(instance *llamav1alpha1.LlamaStackDistribution, co
 	}
 }
 
+// getConfigMountPath returns the config file path, using custom path if specified.
+func getConfigMountPath(instance *llamav1alpha1.LlamaStackDistribution) string {
+	if instance.Spec.Server.ConfigMountPath != "" {
+		return instance.Spec.Server.ConfigMountPath
+	}
+	return llamaStackConfigPath
+}
+
 // addCABundleVolumeMount adds the managed CA bundle volume mount to the container.
 // Mounts the operator-managed ConfigMap containing all concatenated certificates.
 func addCABundleVolumeMount(ctx context.Context, r *LlamaStackDistributionReconciler, instance *llamav1alpha1.LlamaStackDistribution, container *corev1.Container) {
@@ -435,6 +677,9 @@ func configurePodStorage(ctx context.Context, r *LlamaStackDistributionReconcile
 	// Configure user config
 	configureUserConfig(instance, &podSpec)
 
+	// Size the grace period to the drain timeout; an explicit override below wins
+	configureDrainGracePeriod(instance, &podSpec)
+
 	// Apply pod overrides including ServiceAccount, volumes, and volume mounts
 	configurePodOverrides(instance, &podSpec)
 
@@ -445,9 +690,12 @@ func configurePodStorage(ctx context.Context, r *LlamaStackDistributionReconcile
 
 // configureStorage handles storage volume configuration.
 func configureStorage(instance *llamav1alpha1.LlamaStackDistribution, podSpec *corev1.PodSpec) {
-	if instance.Spec.Server.Storage != nil {
+	switch {
+	case instance.IsStorageDisabled():
+		return
+	case instance.HasPersistentStorage():
 		configurePersistentStorage(instance, podSpec)
-	} else {
+	default:
 		configureEmptyDirStorage(podSpec)
 	}
 }
@@ -459,7 +707,7 @@ func configurePersistentStorage(instance *llamav1alpha1.LlamaStackDistribution,
 		Name: "lls-storage",
 		VolumeSource: corev1.VolumeSource{
 			PersistentVolumeClaim: &corev1.PersistentVolumeClaimVolumeSource{
-				ClaimName: instance.Name + "-pvc",
+				ClaimName: instance.PVCName(),
 			},
 		},
 	})
@@ -490,6 +738,16 @@ func configureTLSCABundle(ctx context.Context, r *LlamaStackDistributionReconcil
 	podSpec.Volumes = append(podSpec.Volumes, volume)
 }
 
+// getUserConfigMapMountName returns the name of the user config ConfigMap mounted into the pod.
+// ConfigMaps from another namespace are mounted through an owned copy in the instance namespace.
+func getUserConfigMapMountName(instance *llamav1alpha1.LlamaStackDistribution) string {
+	userConfig := instance.Spec.Server.UserConfig
+	if userConfig.ConfigMapNamespace == "" || userConfig.ConfigMapNamespace == instance.Namespace {
+		return userConfig.ConfigMapName
+	}
+	return instance.Name + "-" + userConfig.ConfigMapName
+}
+
 // configureUserConfig handles user configuration setup.
 func configureUserConfig(instance *llamav1alpha1.LlamaStackDistribution, podSpec *corev1.PodSpec) {
 	userConfig := instance.Spec.Server.UserConfig
@@ -503,7 +761,7 @@ func configureUserConfig(instance *llamav1alpha1.LlamaStackDistribution, podSpec
 		VolumeSource: corev1.VolumeSource{
 			ConfigMap: &corev1.ConfigMapVolumeSource{
 				LocalObjectReference: corev1.LocalObjectReference{
-					Name: userConfig.ConfigMapName,
+					Name: getUserConfigMapMountName(instance),
 				},
 			},
 		},
@@ -537,6 +795,17 @@ func configurePodOverrides(instance *llamav1alpha1.LlamaStackDistribution, podSp
 		if instance.Spec.Server.PodOverrides.TerminationGracePeriodSeconds != nil {
 			podSpec.TerminationGracePeriodSeconds = instance.Spec.Server.PodOverrides.TerminationGracePeriodSeconds
 		}
+
+		// Apply fsGroup if specified, replacing the operator default
+		if instance.Spec.Server.PodOverrides.FSGroup != nil {
+			fsGroup := *instance.Spec.Server.PodOverrides.FSGroup
+			podSpec.SecurityContext.FSGroup = &fsGroup
+		}
+
+		// Apply the user affinity, which takes the place of the default anti-affinity
+		if instance.Spec.Server.PodOverrides.Affinity != nil {
+			podSpec.Affinity = instance.Spec.Server.PodOverrides.Affinity.DeepCopy()
+		}
 	}
 }
 
@@ -547,7 +816,8 @@ func configurePodScheduling(instance *llamav1alpha1.LlamaStackDistribution, podS
 		podSpec.TopologySpreadConstraints = defaultTopologySpreadConstraints(instance)
 	}
 
-	if instance.Spec.Replicas > 1 {
+	hasUserAffinity := instance.Spec.Server.PodOverrides != nil && instance.Spec.Server.PodOverrides.Affinity != nil
+	if instance.Spec.Replicas > 1 && !hasUserAffinity {
 		ensureDefaultPodAntiAffinity(instance, podSpec)
 	}
 }
@@ -621,7 +891,7 @@ func (r *LlamaStackDistributionReconciler) validateDistribution(instance *llamav
 		if r.ClusterInfo == nil {
 			return errors.New("failed to initialize cluster info")
 		}
-		if _, exists := r.ClusterInfo.DistributionImages[instance.Spec.Server.Distribution.Name]; !exists {
+		if _, exists := r.distributionImages()[instance.Spec.Server.Distribution.Name]; !exists {
 			return fmt.Errorf("failed to validate distribution: %s. Distribution name not supported", instance.Spec.Server.Distribution.Name)
 		}
 	}
@@ -629,10 +899,133 @@ func (r *LlamaStackDistributionReconciler) validateDistribution(instance *llamav
 	return nil
 }
 
+// getDrainTimeoutSeconds returns the configured drain timeout, or 0 when draining is not configured.
+func getDrainTimeoutSeconds(instance *llamav1alpha1.LlamaStackDistribution) int32 {
+	if instance.Spec.Network == nil || instance.Spec.Network.DrainTimeoutSeconds == nil {
+		return 0
+	}
+	return *instance.Spec.Network.DrainTimeoutSeconds
+}
+
+// drainScript asks the server to stop accepting requests, then keeps the container alive
+// for what is left of the drain timeout while the pod leaves the Service endpoints.
+// Arguments: drain URL, drain timeout in seconds.
+const drainScript = `import sys, time, urllib.request
+timeout = int(sys.argv[2])
+deadline = time.monotonic() + timeout
+try:
+    urllib.request.urlopen(urllib.request.Request(sys.argv[1], method="POST"), timeout=timeout)
+except Exception as e:
+    print(f"drain request failed: {e}", file=sys.stderr)
+time.sleep(max(0, deadline - time.monotonic()))
+`
+
+// getDrainURL returns the URL of the server's drain endpoint as seen from inside the pod.
+func getDrainURL(instance *llamav1alpha1.LlamaStackDistribution) string {
+	path := defaultDrainPath
+	if instance.Spec.Network != nil && instance.Spec.Network.DrainPath != "" {
+		path = instance.Spec.Network.DrainPath
+	}
+	return fmt.Sprintf("http://127.0.0.1:%d%s", getContainerPort(instance), path)
+}
+
+// getLifecycle returns the container lifecycle, adding a preStop hook that calls the drain
+// endpoint and waits for the drain timeout when the user did not define a preStop hook.
+func getLifecycle(instance *llamav1alpha1.LlamaStackDistribution) *corev1.Lifecycle {
+	lifecycle := instance.Spec.Server.ContainerSpec.Lifecycle
+	drainTimeout := getDrainTimeoutSeconds(instance)
+	if drainTimeout == 0 || (lifecycle != nil && lifecycle.PreStop != nil) {
+		return lifecycle
+	}
+
+	if lifecycle == nil {
+		lifecycle = &corev1.Lifecycle{}
+	} else {
+		lifecycle = lifecycle.DeepCopy()
+	}
+	lifecycle.PreStop = &corev1.LifecycleHandler{
+		Exec: &corev1.ExecAction{
+			Command: []string{"python3", "-c", drainScript, getDrainURL(instance), strconv.Itoa(int(drainTimeout))},
+		},
+	}
+	return lifecycle
+}
+
+// configureDrainGracePeriod sizes the termination grace period so the pod is not killed
+// before the drain sleep and the server shutdown have completed.
+func configureDrainGracePeriod(instance *llamav1alpha1.LlamaStackDistribution, podSpec *corev1.PodSpec) {
+	drainTimeout := getDrainTimeoutSeconds(instance)
+	if drainTimeout == 0 {
+		return
+	}
+	gracePeriod := int64(drainTimeout) + corev1.DefaultTerminationGracePeriodSeconds
+	podSpec.TerminationGracePeriodSeconds = &gracePeriod
+}
+
+// getBindAddress returns the configured listen address, or an empty string for the default.
+func getBindAddress(instance *llamav1alpha1.LlamaStackDistribution) string {
+	if instance.Spec.Network == nil {
+		return ""
+	}
+	return instance.Spec.Network.BindAddress
+}
+
+// validateBindAddress validates that the configured listen address is an IP address that
+// the startup script can pass to the server.
+func validateBindAddress(instance *llamav1alpha1.LlamaStackDistribution) error {
+	bindAddress := getBindAddress(instance)
+	if bindAddress == "" {
+		return nil
+	}
+	if net.ParseIP(bindAddress) == nil {
+		return fmt.Errorf("failed to validate network.bindAddress: %q is not a valid IP address", bindAddress)
+	}
+	// Only the startup script passes the address to the server.
+	if !usesStartupScript(instance) {
+		return errors.New("failed to validate network.bindAddress: it requires userConfig and no custom containerSpec.command")
+	}
+	return nil
+}
+
+// validateExtraArgs validates that extraArgs has something to be appended to. Without the
+// startup script or a custom command, the args would replace the image's default command.
+func validateExtraArgs(instance *llamav1alpha1.LlamaStackDistribution) error {
+	containerSpec := instance.Spec.Server.ContainerSpec
+	if len(containerSpec.ExtraArgs) == 0 || len(containerSpec.Args) > 0 || len(containerSpec.Command) > 0 {
+		return nil
+	}
+	if instance.Spec.Server.UserConfig == nil || instance.Spec.Server.UserConfig.ConfigMapName == "" {
+		return errors.New("failed to validate containerSpec.extraArgs: it requires userConfig or a custom containerSpec.command")
+	}
+	return nil
+}
+
+// distributionHealthPaths returns the health endpoints read from the distributions
+// ConfigMap. It tolerates a nil reconciler so container specs can be built standalone.
+func (r *LlamaStackDistributionReconciler) distributionHealthPaths() map[string]string {
+	if r == nil {
+		return nil
+	}
+	return r.DistributionHealthPaths
+}
+
+// distributionImages returns the embedded distribution images merged with the
+// images read at runtime from the distributions ConfigMap (runtime entries win).
+func (r *LlamaStackDistributionReconciler) distributionImages() map[string]string {
+	if len(r.RuntimeDistributionImages) == 0 {
+		return r.ClusterInfo.DistributionImages
+	}
+
+	images := make(map[string]string, len(r.ClusterInfo.DistributionImages)+len(r.RuntimeDistributionImages))
+	maps.Copy(images, r.ClusterInfo.DistributionImages)
+	maps.Copy(images, r.RuntimeDistributionImages)
+	return images
+}
+
 // resolveImage determines the container image to use based on the distribution configuration.
 // It returns the resolved image and any error encountered.
 func (r *LlamaStackDistributionReconciler) resolveImage(distribution llamav1alpha1.DistributionType) (string, error) {
-	distributionMap := r.ClusterInfo.DistributionImages
+	distributionMap := r.distributionImages()
 	switch {
 	case distribution.Name != "":
 		if _, exists := distributionMap[distribution.Name]; !exists {
@@ -27,15 +27,28 @@ import (
 	appsv1 "k8s.io/api/apps/v1"
 	autoscalingv2 "k8s.io/api/autoscaling/v2"
 	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/meta/testrestmapper"
 	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/util/intstr"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
 )
 
+func boolPtr(val bool) *bool {
+	return &val
+}
+
 func int32Ptr(val int32) *int32 {
 	return &val
 }
 
+func int64Ptr(val int64) *int64 {
+	return &val
+}
+
 func TestBuildContainerSpec(t *testing.T) {
 	testCases := []struct {
 		name           string
@@ -62,8 +75,8 @@ func TestBuildContainerSpec(t *testing.T) {
 						corev1.ResourceMemory: llamav1alpha1.DefaultServerMemoryRequest,
 					},
 				},
-				Ports:        []corev1.ContainerPort{{ContainerPort: llamav1alpha1.DefaultServerPort}},
-				StartupProbe: newDefaultStartupProbe(llamav1alpha1.DefaultServerPort),
+				Ports:        []corev1.ContainerPort{{Name: llamav1alpha1.DefaultContainerPortName, ContainerPort: llamav1alpha1.DefaultServerPort}},
+				StartupProbe: newDefaultStartupProbe(),
 				VolumeMounts: []corev1.VolumeMount{{
 					Name:      "lls-storage",
 					MountPath: llamav1alpha1.DefaultMountPath,
@@ -104,8 +117,8 @@ func TestBuildContainerSpec(t *testing.T) {
 			expectedResult: corev1.Container{
 				Name:         "custom-container",
 				Image:        "test-image:latest",
-				Ports:        []corev1.ContainerPort{{ContainerPort: 9000}},
-				StartupProbe: newDefaultStartupProbe(9000),
+				Ports:        []corev1.ContainerPort{{Name: llamav1alpha1.DefaultContainerPortName, ContainerPort: 9000}},
+				StartupProbe: newDefaultStartupProbe(),
 				Resources: corev1.ResourceRequirements{
 					Requests: corev1.ResourceList{
 						corev1.ResourceCPU:    resource.MustParse("1"),
@@ -154,8 +167,8 @@ func TestBuildContainerSpec(t *testing.T) {
 				},
 				Command:      []string{"/custom/entrypoint.sh"},
 				Args:         []string{"--config", "/etc/config.yaml", "--debug"},
-				Ports:        []corev1.ContainerPort{{ContainerPort: llamav1alpha1.DefaultServerPort}},
-				StartupProbe: newDefaultStartupProbe(llamav1alpha1.DefaultServerPort),
+				Ports:        []corev1.ContainerPort{{Name: llamav1alpha1.DefaultContainerPortName, ContainerPort: llamav1alpha1.DefaultServerPort}},
+				StartupProbe: newDefaultStartupProbe(),
 				VolumeMounts: []corev1.VolumeMount{{
 					Name:      "lls-storage",
 					MountPath: llamav1alpha1.DefaultMountPath,
@@ -191,8 +204,8 @@ func TestBuildContainerSpec(t *testing.T) {
 						corev1.ResourceMemory: llamav1alpha1.DefaultServerMemoryRequest,
 					},
 				},
-				Ports:        []corev1.ContainerPort{{ContainerPort: llamav1alpha1.DefaultServerPort}},
-				StartupProbe: newDefaultStartupProbe(llamav1alpha1.DefaultServerPort),
+				Ports:        []corev1.ContainerPort{{Name: llamav1alpha1.DefaultContainerPortName, ContainerPort: llamav1alpha1.DefaultServerPort}},
+				StartupProbe: newDefaultStartupProbe(),
 				Env: []corev1.EnvVar{
 					{Name: "HF_HOME", Value: "/.llama"},
 					{Name: "LLS_WORKERS", Value: "4"},
@@ -205,6 +218,218 @@ func TestBuildContainerSpec(t *testing.T) {
 				}},
 			},
 		},
+		{
+			name: "log level and access log configured",
+			instance: &llamav1alpha1.LlamaStackDistribution{
+				Spec: llamav1alpha1.LlamaStackDistributionSpec{
+					Server: llamav1alpha1.ServerSpec{
+						LogLevel:  "debug",
+						AccessLog: boolPtr(false),
+						ContainerSpec: llamav1alpha1.ContainerSpec{
+							Env: []corev1.EnvVar{
+								{Name: "LLAMA_STACK_LOGGING", Value: "all=warning"},
+							},
+						},
+					},
+				},
+			},
+			image: "test-image:latest",
+			expectedResult: corev1.Container{
+				Name:  llamav1alpha1.DefaultContainerName,
+				Image: "test-image:latest",
+				Resources: corev1.ResourceRequirements{
+					Requests: corev1.ResourceList{
+						corev1.ResourceCPU:    resource.MustParse("1"),
+						corev1.ResourceMemory: llamav1alpha1.DefaultServerMemoryRequest,
+					},
+				},
+				Ports:        []corev1.ContainerPort{{Name: llamav1alpha1.DefaultContainerPortName, ContainerPort: llamav1alpha1.DefaultServerPort}},
+				StartupProbe: newDefaultStartupProbe(),
+				Env: []corev1.EnvVar{
+					{Name: "HF_HOME", Value: "/.llama"},
+					{Name: "LLS_WORKERS", Value: "1"},
+					{Name: "LLS_PORT", Value: "8321"},
+					{Name: "LLAMA_STACK_CONFIG", Value: "/etc/llama-stack/config.yaml"},
+					{Name: "LLS_LOG_LEVEL", Value: "debug"},
+					{Name: "LLS_ACCESS_LOG", Value: "false"},
+					{Name: "LLAMA_STACK_LOGGING", Value: "all=warning"},
+				},
+				VolumeMounts: []corev1.VolumeMount{{
+					Name:      "lls-storage",
+					MountPath: llamav1alpha1.DefaultMountPath,
+				}},
+			},
+		},
+		{
+			name: "working directory and user configured",
+			instance: &llamav1alpha1.LlamaStackDistribution{
+				Spec: llamav1alpha1.LlamaStackDistributionSpec{
+					Server: llamav1alpha1.ServerSpec{
+						ContainerSpec: llamav1alpha1.ContainerSpec{
+							Command:    []string{"/app/entrypoint.sh"},
+							WorkingDir: "/app",
+							RunAsUser:  int64Ptr(1500),
+						},
+					},
+				},
+			},
+			image: "test-image:latest",
+			expectedResult: corev1.Container{
+				Name:  llamav1alpha1.DefaultContainerName,
+				Image: "test-image:latest",
+				Resources: corev1.ResourceRequirements{
+					Requests: corev1.ResourceList{
+						corev1.ResourceCPU:    resource.MustParse("1"),
+						corev1.ResourceMemory: llamav1alpha1.DefaultServerMemoryRequest,
+					},
+				},
+				Ports:        []corev1.ContainerPort{{Name: llamav1alpha1.DefaultContainerPortName, ContainerPort: llamav1alpha1.DefaultServerPort}},
+				StartupProbe: newDefaultStartupProbe(),
+				Command:      []string{"/app/entrypoint.sh"},
+				WorkingDir:   "/app",
+				SecurityContext: &corev1.SecurityContext{
+					RunAsUser: int64Ptr(1500),
+				},
+				Env: []corev1.EnvVar{
+					{Name: "HF_HOME", Value: "/.llama"},
+					{Name: "LLS_WORKERS", Value: "1"},
+					{Name: "LLS_PORT", Value: "8321"},
+					{Name: "LLAMA_STACK_CONFIG", Value: "/etc/llama-stack/config.yaml"},
+				},
+				VolumeMounts: []corev1.VolumeMount{{
+					Name:      "lls-storage",
+					MountPath: llamav1alpha1.DefaultMountPath,
+				}},
+			},
+		},
+		{
+			name: "proxy configured with user override",
+			instance: &llamav1alpha1.LlamaStackDistribution{
+				Spec: llamav1alpha1.LlamaStackDistributionSpec{
+					Server: llamav1alpha1.ServerSpec{
+						Proxy: &llamav1alpha1.ProxySpec{
+							HTTPProxy:  "http://proxy.example.com:3128",
+							HTTPSProxy: "http://proxy.example.com:3128",
+							NoProxy:    ".svc,.cluster.local",
+						},
+						ContainerSpec: llamav1alpha1.ContainerSpec{
+							Env: []corev1.EnvVar{
+								{Name: "NO_PROXY", Value: "localhost"},
+							},
+						},
+					},
+				},
+			},
+			image: "test-image:latest",
+			expectedResult: corev1.Container{
+				Name:  llamav1alpha1.DefaultContainerName,
+				Image: "test-image:latest",
+				Resources: corev1.ResourceRequirements{
+					Requests: corev1.ResourceList{
+						corev1.ResourceCPU:    resource.MustParse("1"),
+						corev1.ResourceMemory: llamav1alpha1.DefaultServerMemoryRequest,
+					},
+				},
+				Ports:        []corev1.ContainerPort{{Name: llamav1alpha1.DefaultContainerPortName, ContainerPort: llamav1alpha1.DefaultServerPort}},
+				StartupProbe: newDefaultStartupProbe(),
+				Env: []corev1.EnvVar{
+					{Name: "HF_HOME", Value: "/.llama"},
+					{Name: "LLS_WORKERS", Value: "1"},
+					{Name: "LLS_PORT", Value: "8321"},
+					{Name: "LLAMA_STACK_CONFIG", Value: "/etc/llama-stack/config.yaml"},
+					{Name: "HTTP_PROXY", Value: "http://proxy.example.com:3128"},
+					{Name: "HTTPS_PROXY", Value: "http://proxy.example.com:3128"},
+					{Name: "NO_PROXY", Value: "localhost"},
+				},
+				VolumeMounts: []corev1.VolumeMount{{
+					Name:      "lls-storage",
+					MountPath: llamav1alpha1.DefaultMountPath,
+				}},
+			},
+		},
+		{
+			name: "downward env injected",
+			instance: &llamav1alpha1.LlamaStackDistribution{
+				Spec: llamav1alpha1.LlamaStackDistributionSpec{
+					Server: llamav1alpha1.ServerSpec{
+						ContainerSpec: llamav1alpha1.ContainerSpec{
+							InjectDownwardEnv: true,
+						},
+					},
+				},
+			},
+			image: "test-image:latest",
+			expectedResult: corev1.Container{
+				Name:  llamav1alpha1.DefaultContainerName,
+				Image: "test-image:latest",
+				Resources: corev1.ResourceRequirements{
+					Requests: corev1.ResourceList{
+						corev1.ResourceCPU:    resource.MustParse("1"),
+						corev1.ResourceMemory: llamav1alpha1.DefaultServerMemoryRequest,
+					},
+				},
+				Ports:        []corev1.ContainerPort{{Name: llamav1alpha1.DefaultContainerPortName, ContainerPort: llamav1alpha1.DefaultServerPort}},
+				StartupProbe: newDefaultStartupProbe(),
+				Env: []corev1.EnvVar{
+					{Name: "HF_HOME", Value: "/.llama"},
+					{Name: "LLS_WORKERS", Value: "1"},
+					{Name: "LLS_PORT", Value: "8321"},
+					{Name: "LLAMA_STACK_CONFIG", Value: "/etc/llama-stack/config.yaml"},
+					{Name: "POD_NAME", ValueFrom: &corev1.EnvVarSource{FieldRef: &corev1.ObjectFieldSelector{FieldPath: "metadata.name"}}},
+					{Name: "POD_NAMESPACE", ValueFrom: &corev1.EnvVarSource{FieldRef: &corev1.ObjectFieldSelector{FieldPath: "metadata.namespace"}}},
+					{Name: "POD_IP", ValueFrom: &corev1.EnvVarSource{FieldRef: &corev1.ObjectFieldSelector{FieldPath: "status.podIP"}}},
+					{Name: "NODE_NAME", ValueFrom: &corev1.EnvVarSource{FieldRef: &corev1.ObjectFieldSelector{FieldPath: "spec.nodeName"}}},
+				},
+				VolumeMounts: []corev1.VolumeMount{{
+					Name:      "lls-storage",
+					MountPath: llamav1alpha1.DefaultMountPath,
+				}},
+			},
+		},
+		{
+			name: "lifecycle preStop hook",
+			instance: &llamav1alpha1.LlamaStackDistribution{
+				Spec: llamav1alpha1.LlamaStackDistributionSpec{
+					Server: llamav1alpha1.ServerSpec{
+						ContainerSpec: llamav1alpha1.ContainerSpec{
+							Lifecycle: &corev1.Lifecycle{
+								PreStop: &corev1.LifecycleHandler{
+									Sleep: &corev1.SleepAction{Seconds: 10},
+								},
+							},
+						},
+					},
+				},
+			},
+			image: "test-image:latest",
+			expectedResult: corev1.Container{
+				Name:  llamav1alpha1.DefaultContainerName,
+				Image: "test-image:latest",
+				Resources: corev1.ResourceRequirements{
+					Requests: corev1.ResourceList{
+						corev1.ResourceCPU:    resource.MustParse("1"),
+						corev1.ResourceMemory: llamav1alpha1.DefaultServerMemoryRequest,
+					},
+				},
+				Ports:        []corev1.ContainerPort{{Name: llamav1alpha1.DefaultContainerPortName, ContainerPort: llamav1alpha1.DefaultServerPort}},
+				StartupProbe: newDefaultStartupProbe(),
+				Lifecycle: &corev1.Lifecycle{
+					PreStop: &corev1.LifecycleHandler{
+						Sleep: &corev1.SleepAction{Seconds: 10},
+					},
+				},
+				Env: []corev1.EnvVar{
+					{Name: "HF_HOME", Value: "/.llama"},
+					{Name: "LLS_WORKERS", Value: "1"},
+					{Name: "LLS_PORT", Value: "8321"},
+					{Name: "LLAMA_STACK_CONFIG", Value: "/etc/llama-stack/config.yaml"},
+				},
+				VolumeMounts: []corev1.VolumeMount{{
+					Name:      "lls-storage",
+					MountPath: llamav1alpha1.DefaultMountPath,
+				}},
+			},
+		},
 		{
 			name: "with user config",
 			instance: &llamav1alpha1.LlamaStackDistribution{
@@ -231,8 +456,8 @@ func TestBuildContainerSpec(t *testing.T) {
 						corev1.ResourceMemory: llamav1alpha1.DefaultServerMemoryRequest,
 					},
 				},
-				Ports:        []corev1.ContainerPort{{ContainerPort: llamav1alpha1.DefaultServerPort}},
-				StartupProbe: newDefaultStartupProbe(llamav1alpha1.DefaultServerPort),
+				Ports:        []corev1.ContainerPort{{Name: llamav1alpha1.DefaultContainerPortName, ContainerPort: llamav1alpha1.DefaultServerPort}},
+				StartupProbe: newDefaultStartupProbe(),
 				Command:      []string{"/bin/sh", "-c", startupScript},
 				Args:         []string{},
 				Env: []corev1.EnvVar{
@@ -254,6 +479,52 @@ func TestBuildContainerSpec(t *testing.T) {
 				},
 			},
 		},
+		{
+			name: "with user config at custom mount path",
+			instance: &llamav1alpha1.LlamaStackDistribution{
+				Spec: llamav1alpha1.LlamaStackDistributionSpec{
+					Server: llamav1alpha1.ServerSpec{
+						UserConfig: &llamav1alpha1.UserConfigSpec{
+							ConfigMapName: "test-config",
+						},
+						ConfigMountPath: "/app/run.yaml",
+					},
+				},
+			},
+			image: "test-image:latest",
+			expectedResult: corev1.Container{
+				Name:  llamav1alpha1.DefaultContainerName,
+				Image: "test-image:latest",
+				Resources: corev1.ResourceRequirements{
+					Requests: corev1.ResourceList{
+						corev1.ResourceCPU:    resource.MustParse("1"),
+						corev1.ResourceMemory: llamav1alpha1.DefaultServerMemoryRequest,
+					},
+				},
+				Ports:        []corev1.ContainerPort{{Name: llamav1alpha1.DefaultContainerPortName, ContainerPort: llamav1alpha1.DefaultServerPort}},
+				StartupProbe: newDefaultStartupProbe(),
+				Command:      []string{"/bin/sh", "-c", startupScript},
+				Args:         []string{},
+				Env: []corev1.EnvVar{
+					{Name: "HF_HOME", Value: llamav1alpha1.DefaultMountPath},
+					{Name: "LLS_WORKERS", Value: "1"},
+					{Name: "LLS_PORT", Value: "8321"},
+					{Name: "LLAMA_STACK_CONFIG", Value: "/app/run.yaml"},
+				},
+				VolumeMounts: []corev1.VolumeMount{
+					{
+						Name:      "lls-storage",
+						MountPath: llamav1alpha1.DefaultMountPath,
+					},
+					{
+						Name:      "user-config",
+						MountPath: "/app/run.yaml",
+						SubPath:   "config.yaml",
+						ReadOnly:  true,
+					},
+				},
+			},
+		},
 	}
 
 	for _, tc := range testCases {
@@ -268,6 +539,9 @@ func TestBuildContainerSpec(t *testing.T) {
 			assert.Equal(t, tc.expectedResult.Command, result.Command)
 			assert.Equal(t, tc.expectedResult.Args, result.Args)
 			assert.Equal(t, tc.expectedResult.StartupProbe, result.StartupProbe)
+			assert.Equal(t, tc.expectedResult.Lifecycle, result.Lifecycle)
+			assert.Equal(t, tc.expectedResult.WorkingDir, result.WorkingDir)
+			assert.Equal(t, tc.expectedResult.SecurityContext, result.SecurityContext)
 		})
 	}
 }
@@ -364,44 +638,133 @@ func TestConfigurePodStorage(t *testing.T) {
 	}
 }
 
-// verifyStorageVolumes validates that the correct storage volumes are configured.
-func verifyStorageVolumes(t *testing.T, podSpec corev1.PodSpec, instance *llamav1alpha1.LlamaStackDistribution,
-	expectPVC, expectEmptyDir bool) {
-	t.Helper()
-
-	if expectPVC {
-		pvcFound := false
-		for _, vol := range podSpec.Volumes {
-			if vol.Name == "lls-storage" && vol.PersistentVolumeClaim != nil {
-				pvcFound = true
-				assert.Equal(t, instance.Name+"-pvc", vol.PersistentVolumeClaim.ClaimName)
-				break
-			}
-		}
-		assert.True(t, pvcFound, "Expected PVC volume not found")
+func TestConfigurePodStorage_Disabled(t *testing.T) {
+	instance := &llamav1alpha1.LlamaStackDistribution{
+		Spec: llamav1alpha1.LlamaStackDistributionSpec{
+			Server: llamav1alpha1.ServerSpec{
+				Storage:    &llamav1alpha1.StorageSpec{Disabled: true},
+				UserConfig: &llamav1alpha1.UserConfigSpec{ConfigMapName: "external-state-config"},
+			},
+		},
 	}
 
-	if expectEmptyDir {
-		emptyDirFound := false
-		for _, vol := range podSpec.Volumes {
-			if vol.Name == "lls-storage" && vol.EmptyDir != nil {
-				emptyDirFound = true
-				break
-			}
-		}
-		assert.True(t, emptyDirFound, "Expected EmptyDir volume not found")
+	container := buildContainerSpec(t.Context(), nil, instance, "test-image:latest")
+	result := configurePodStorage(t.Context(), nil, instance, container)
+
+	for _, vol := range result.Volumes {
+		assert.NotEqual(t, "lls-storage", vol.Name, "storage volume should not be created when disabled")
+	}
+	for _, mount := range result.Containers[0].VolumeMounts {
+		assert.NotEqual(t, "lls-storage", mount.Name, "storage mount should not be added when disabled")
+	}
+	for _, env := range result.Containers[0].Env {
+		assert.NotEqual(t, "HF_HOME", env.Name, "HF_HOME should not point at a missing volume")
 	}
 }
 
-// verifyPodOverrides validates that pod overrides are correctly applied.
-func verifyPodOverrides(t *testing.T, podSpec corev1.PodSpec, instance *llamav1alpha1.LlamaStackDistribution) {
-	t.Helper()
+func TestConfigurePodStorage_ExistingClaim(t *testing.T) {
+	instance := &llamav1alpha1.LlamaStackDistribution{
+		ObjectMeta: metav1.ObjectMeta{Name: "existing"},
+		Spec: llamav1alpha1.LlamaStackDistributionSpec{
+			Server: llamav1alpha1.ServerSpec{
+				Storage: &llamav1alpha1.StorageSpec{ExistingClaimName: "shared-models"},
+			},
+		},
+	}
 
-	if instance.Spec.Server.PodOverrides == nil {
-		return
+	container := buildContainerSpec(t.Context(), nil, instance, "test-image:latest")
+	result := configurePodStorage(t.Context(), nil, instance, container)
+
+	var storageVolume *corev1.Volume
+	for i := range result.Volumes {
+		if result.Volumes[i].Name == "lls-storage" {
+			storageVolume = &result.Volumes[i]
+		}
 	}
+	require.NotNil(t, storageVolume)
+	require.NotNil(t, storageVolume.PersistentVolumeClaim)
+	assert.Equal(t, "shared-models", storageVolume.PersistentVolumeClaim.ClaimName)
 
-	verifyServiceAccount(t, podSpec, instance)
+	// The operator must not render its own PVC for an existing claim.
+	r := &LlamaStackDistributionReconciler{}
+	assert.Contains(t, r.determineKindsToExclude(instance), "PersistentVolumeClaim")
+}
+
+func TestConfigurePodStorage_FSGroup(t *testing.T) {
+	testCases := []struct {
+		name            string
+		podOverrides    *llamav1alpha1.PodOverrides
+		expectedFSGroup int64
+	}{
+		{
+			name:            "PVC storage uses default fsGroup",
+			expectedFSGroup: FSGroup,
+		},
+		{
+			name:            "PVC storage with fsGroup override",
+			podOverrides:    &llamav1alpha1.PodOverrides{FSGroup: int64Ptr(2000)},
+			expectedFSGroup: 2000,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			instance := &llamav1alpha1.LlamaStackDistribution{
+				Spec: llamav1alpha1.LlamaStackDistributionSpec{
+					Server: llamav1alpha1.ServerSpec{
+						Storage:      &llamav1alpha1.StorageSpec{},
+						PodOverrides: tc.podOverrides,
+					},
+				},
+			}
+
+			result := configurePodStorage(t.Context(), nil, instance, corev1.Container{Name: "test-container"})
+
+			require.NotNil(t, result.SecurityContext)
+			require.NotNil(t, result.SecurityContext.FSGroup)
+			assert.Equal(t, tc.expectedFSGroup, *result.SecurityContext.FSGroup)
+		})
+	}
+}
+
+// verifyStorageVolumes validates that the correct storage volumes are configured.
+func verifyStorageVolumes(t *testing.T, podSpec corev1.PodSpec, instance *llamav1alpha1.LlamaStackDistribution,
+	expectPVC, expectEmptyDir bool) {
+	t.Helper()
+
+	if expectPVC {
+		pvcFound := false
+		for _, vol := range podSpec.Volumes {
+			if vol.Name == "lls-storage" && vol.PersistentVolumeClaim != nil {
+				pvcFound = true
+				assert.Equal(t, instance.Name+"-pvc", vol.PersistentVolumeClaim.ClaimName)
+				break
+			}
+		}
+		assert.True(t, pvcFound, "Expected PVC volume not found")
+	}
+
+	if expectEmptyDir {
+		emptyDirFound := false
+		for _, vol := range podSpec.Volumes {
+			if vol.Name == "lls-storage" && vol.EmptyDir != nil {
+				emptyDirFound = true
+				break
+			}
+		}
+		assert.True(t, emptyDirFound, "Expected EmptyDir volume not found")
+	}
+}
+
+// verifyPodOverrides validates that pod overrides are correctly applied.
+func verifyPodOverrides(t *testing.T, podSpec corev1.PodSpec, instance *llamav1alpha1.LlamaStackDistribution) {
+	t.Helper()
+
+	if instance.Spec.Server.PodOverrides == nil {
+		return
+	}
+
+	verifyServiceAccount(t, podSpec, instance)
 	verifyVolumes(t, podSpec, instance)
 	verifyVolumeMounts(t, podSpec, instance)
 }
@@ -522,6 +885,45 @@ func TestResolveImage(t *testing.T) {
 	}
 }
 
+func TestResolveImageWithRuntimeDistributions(t *testing.T) {
+	clusterInfo := setupTestClusterInfo(map[string]string{
+		"ollama": "ollama-image:latest",
+	})
+	r := &LlamaStackDistributionReconciler{
+		ClusterInfo: clusterInfo,
+		RuntimeDistributionImages: map[string]string{
+			"custom": "quay.io/custom/distribution:latest",
+			"ollama": "quay.io/custom/ollama:latest",
+		},
+	}
+
+	// A distribution only known through the ConfigMap validates and resolves.
+	instance := createLSD("custom", "")
+	require.NoError(t, r.validateDistribution(instance))
+	image, err := r.resolveImage(instance.Spec.Server.Distribution)
+	require.NoError(t, err)
+	assert.Equal(t, "quay.io/custom/distribution:latest", image)
+
+	// ConfigMap entries take precedence over the embedded images.
+	image, err = r.resolveImage(createLSD("ollama", "").Spec.Server.Distribution)
+	require.NoError(t, err)
+	assert.Equal(t, "quay.io/custom/ollama:latest", image)
+
+	// Image overrides still win over both.
+	r.ImageMappingOverrides = map[string]string{"custom": "quay.io/override/distribution:v2"}
+	image, err = r.resolveImage(instance.Spec.Server.Distribution)
+	require.NoError(t, err)
+	assert.Equal(t, "quay.io/override/distribution:v2", image)
+
+	// The embedded map is not mutated by the merge.
+	assert.NotContains(t, clusterInfo.DistributionImages, "custom")
+
+	// Status reports the merged set of distributions.
+	r.updateDistributionConfig(instance)
+	assert.Contains(t, instance.Status.DistributionConfig.AvailableDistributions, "custom")
+	assert.Equal(t, "custom", instance.Status.DistributionConfig.ActiveDistribution)
+}
+
 func TestDistributionValidation(t *testing.T) {
 	// Setup test cluster info
 	clusterInfo := setupTestClusterInfo(map[string]string{
@@ -752,6 +1154,607 @@ func TestPodOverridesWithoutTerminationGracePeriod(t *testing.T) {
 	assert.Nil(t, deployment.Spec.Template.Spec.TerminationGracePeriodSeconds)
 }
 
+func TestDrainTimeout(t *testing.T) {
+	newInstance := func(containerSpec llamav1alpha1.ContainerSpec, podOverrides *llamav1alpha1.PodOverrides) *llamav1alpha1.LlamaStackDistribution {
+		return &llamav1alpha1.LlamaStackDistribution{
+			ObjectMeta: metav1.ObjectMeta{Name: "test-instance", Namespace: "test-namespace"},
+			Spec: llamav1alpha1.LlamaStackDistributionSpec{
+				Network: &llamav1alpha1.NetworkSpec{DrainTimeoutSeconds: int32Ptr(15)},
+				Server: llamav1alpha1.ServerSpec{
+					ContainerSpec: containerSpec,
+					PodOverrides:  podOverrides,
+				},
+			},
+		}
+	}
+
+	t.Run("preStop drain and grace period follow the drain timeout", func(t *testing.T) {
+		instance := newInstance(llamav1alpha1.ContainerSpec{}, nil)
+
+		container := buildContainerSpec(t.Context(), nil, instance, "test-image:latest")
+		podSpec := configurePodStorage(t.Context(), nil, instance, container)
+
+		require.NotNil(t, container.Lifecycle)
+		require.NotNil(t, container.Lifecycle.PreStop)
+		require.NotNil(t, container.Lifecycle.PreStop.Exec)
+		command := container.Lifecycle.PreStop.Exec.Command
+		require.Len(t, command, 5)
+		assert.Equal(t, []string{"python3", "-c"}, command[:2])
+		assert.Contains(t, command[2], `method="POST"`, "the hook should call the drain endpoint")
+		assert.Contains(t, command[2], "time.sleep(", "the hook should wait out the drain timeout")
+		assert.Equal(t, "http://127.0.0.1:8321/v1/drain", command[3])
+		assert.Equal(t, "15", command[4], "the hook should be bounded by the drain timeout")
+		require.NotNil(t, podSpec.TerminationGracePeriodSeconds)
+		assert.Equal(t, int64(15)+corev1.DefaultTerminationGracePeriodSeconds, *podSpec.TerminationGracePeriodSeconds)
+	})
+
+	t.Run("preStop calls a custom drain path on the container port", func(t *testing.T) {
+		instance := newInstance(llamav1alpha1.ContainerSpec{Port: 9000}, nil)
+		instance.Spec.Network.DrainPath = "/admin/drain"
+
+		container := buildContainerSpec(t.Context(), nil, instance, "test-image:latest")
+
+		require.NotNil(t, container.Lifecycle.PreStop.Exec)
+		assert.Equal(t, "http://127.0.0.1:9000/admin/drain", container.Lifecycle.PreStop.Exec.Command[3])
+	})
+
+	t.Run("explicit preStop and grace period take precedence", func(t *testing.T) {
+		preStop := &corev1.LifecycleHandler{
+			Exec: &corev1.ExecAction{Command: []string{"/bin/drain.sh"}},
+		}
+		instance := newInstance(
+			llamav1alpha1.ContainerSpec{Lifecycle: &corev1.Lifecycle{PreStop: preStop}},
+			&llamav1alpha1.PodOverrides{TerminationGracePeriodSeconds: int64Ptr(90)},
+		)
+
+		container := buildContainerSpec(t.Context(), nil, instance, "test-image:latest")
+		podSpec := configurePodStorage(t.Context(), nil, instance, container)
+
+		assert.Equal(t, preStop, container.Lifecycle.PreStop)
+		require.NotNil(t, podSpec.TerminationGracePeriodSeconds)
+		assert.Equal(t, int64(90), *podSpec.TerminationGracePeriodSeconds)
+	})
+}
+
+func TestBindAddress(t *testing.T) {
+	userConfig := &llamav1alpha1.UserConfigSpec{ConfigMapName: "config"}
+	tests := []struct {
+		name          string
+		network       *llamav1alpha1.NetworkSpec
+		userConfig    *llamav1alpha1.UserConfigSpec
+		command       []string
+		expectedEnv   string
+		expectedError string
+	}{
+		{
+			name: "default listen address",
+		},
+		{
+			name:        "loopback IPv4",
+			network:     &llamav1alpha1.NetworkSpec{BindAddress: "127.0.0.1"},
+			userConfig:  userConfig,
+			expectedEnv: "127.0.0.1",
+		},
+		{
+			name:        "IPv6 any address",
+			network:     &llamav1alpha1.NetworkSpec{BindAddress: "::"},
+			userConfig:  userConfig,
+			expectedEnv: "::",
+		},
+		{
+			name:          "hostname is rejected",
+			network:       &llamav1alpha1.NetworkSpec{BindAddress: "localhost"},
+			userConfig:    userConfig,
+			expectedError: "not a valid IP address",
+		},
+		{
+			name:          "malformed IP is rejected",
+			network:       &llamav1alpha1.NetworkSpec{BindAddress: "127.0.0.256"},
+			userConfig:    userConfig,
+			expectedError: "not a valid IP address",
+		},
+		{
+			name:          "rejected without userConfig",
+			network:       &llamav1alpha1.NetworkSpec{BindAddress: "127.0.0.1"},
+			expectedError: "requires userConfig",
+		},
+		{
+			name:          "rejected with a custom command",
+			network:       &llamav1alpha1.NetworkSpec{BindAddress: "127.0.0.1"},
+			userConfig:    userConfig,
+			command:       []string{"/app/entrypoint.sh"},
+			expectedError: "requires userConfig",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			instance := &llamav1alpha1.LlamaStackDistribution{
+				Spec: llamav1alpha1.LlamaStackDistributionSpec{
+					Network: tt.network,
+					Server: llamav1alpha1.ServerSpec{
+						UserConfig:    tt.userConfig,
+						ContainerSpec: llamav1alpha1.ContainerSpec{Command: tt.command},
+					},
+				},
+			}
+
+			err := validateBindAddress(instance)
+			if tt.expectedError != "" {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), tt.expectedError)
+				return
+			}
+			require.NoError(t, err)
+
+			container := buildContainerSpec(t.Context(), nil, instance, "test-image:latest")
+			var hostEnv string
+			for _, env := range container.Env {
+				if env.Name == "LLS_HOST" {
+					hostEnv = env.Value
+				}
+			}
+			assert.Equal(t, tt.expectedEnv, hostEnv)
+		})
+	}
+
+	t.Run("every server invocation receives the host", func(t *testing.T) {
+		for _, line := range strings.Split(startupScript, "\n") {
+			line = strings.TrimSpace(line)
+			if strings.HasPrefix(line, "0)") || strings.HasPrefix(line, "1)") {
+				assert.Contains(t, line, `${LLS_HOST:+--host "$HOST"}`)
+			}
+			if strings.Contains(line, "exec uvicorn") {
+				assert.Contains(t, line, `--host "$HOST"`)
+			}
+		}
+	})
+}
+
+func TestHealthPath(t *testing.T) {
+	// A distribution with a non-default health endpoint, as read from the distributions ConfigMap.
+	r := &LlamaStackDistributionReconciler{
+		Client:                  fake.NewClientBuilder().WithScheme(clientgoscheme.Scheme).Build(),
+		DistributionHealthPaths: map[string]string{"custom-health": "/healthz"},
+	}
+
+	tests := []struct {
+		name         string
+		server       llamav1alpha1.ServerSpec
+		expectedPath string
+	}{
+		{
+			name:         "default distribution path",
+			server:       llamav1alpha1.ServerSpec{Distribution: llamav1alpha1.DistributionType{Name: "starter"}},
+			expectedPath: "/v1/health",
+		},
+		{
+			name:         "registered distribution path",
+			server:       llamav1alpha1.ServerSpec{Distribution: llamav1alpha1.DistributionType{Name: "custom-health"}},
+			expectedPath: "/healthz",
+		},
+		{
+			name: "spec override wins over distribution path",
+			server: llamav1alpha1.ServerSpec{
+				Distribution: llamav1alpha1.DistributionType{Name: "custom-health"},
+				HealthPath:   "/health",
+			},
+			expectedPath: "/health",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			instance := &llamav1alpha1.LlamaStackDistribution{
+				Spec: llamav1alpha1.LlamaStackDistributionSpec{Server: tt.server},
+			}
+
+			container := buildContainerSpec(t.Context(), r, instance, "test-image:latest")
+			require.NotNil(t, container.StartupProbe)
+			assert.Equal(t, tt.expectedPath, container.StartupProbe.HTTPGet.Path)
+		})
+	}
+}
+
+func TestGPUResources(t *testing.T) {
+	tests := []struct {
+		name          string
+		containerSpec llamav1alpha1.ContainerSpec
+		resourceName  corev1.ResourceName
+		expectedGPUs  int64
+	}{
+		{
+			name:          "default resource name",
+			containerSpec: llamav1alpha1.ContainerSpec{GPU: &llamav1alpha1.GPUSpec{Count: 2}},
+			resourceName:  "nvidia.com/gpu",
+			expectedGPUs:  2,
+		},
+		{
+			name: "vendor resource name",
+			containerSpec: llamav1alpha1.ContainerSpec{
+				GPU: &llamav1alpha1.GPUSpec{Count: 1, ResourceName: "amd.com/gpu"},
+			},
+			resourceName: "amd.com/gpu",
+			expectedGPUs: 1,
+		},
+		{
+			name: "explicit resources take precedence",
+			containerSpec: llamav1alpha1.ContainerSpec{
+				GPU: &llamav1alpha1.GPUSpec{Count: 1},
+				Resources: corev1.ResourceRequirements{
+					Limits: corev1.ResourceList{"nvidia.com/gpu": resource.MustParse("4")},
+				},
+			},
+			resourceName: "nvidia.com/gpu",
+			expectedGPUs: 4,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			instance := &llamav1alpha1.LlamaStackDistribution{
+				Spec: llamav1alpha1.LlamaStackDistributionSpec{
+					Server: llamav1alpha1.ServerSpec{ContainerSpec: tt.containerSpec},
+				},
+			}
+
+			container := buildContainerSpec(t.Context(), nil, instance, "test-image:latest")
+
+			gpuLimit := container.Resources.Limits[tt.resourceName]
+			assert.Equal(t, tt.expectedGPUs, gpuLimit.Value())
+			// CPU and memory requests are still defaulted alongside the GPU request.
+			assert.Contains(t, container.Resources.Requests, corev1.ResourceCPU)
+			assert.Contains(t, container.Resources.Requests, corev1.ResourceMemory)
+		})
+	}
+}
+
+func TestStartupProbeTuning(t *testing.T) {
+	instance := &llamav1alpha1.LlamaStackDistribution{
+		Spec: llamav1alpha1.LlamaStackDistributionSpec{
+			Server: llamav1alpha1.ServerSpec{
+				StartupProbe: &llamav1alpha1.StartupProbeSpec{
+					FailureThreshold: int32Ptr(60),
+					PeriodSeconds:    int32Ptr(10),
+				},
+			},
+		},
+	}
+
+	container := buildContainerSpec(t.Context(), nil, instance, "test-image:latest")
+
+	require.NotNil(t, container.StartupProbe)
+	assert.Equal(t, int32(60), container.StartupProbe.FailureThreshold)
+	assert.Equal(t, int32(10), container.StartupProbe.PeriodSeconds)
+	// Untuned fields keep their defaults.
+	assert.Equal(t, int32(startupProbeInitialDelaySeconds), container.StartupProbe.InitialDelaySeconds)
+	assert.Equal(t, int32(startupProbeTimeoutSeconds), container.StartupProbe.TimeoutSeconds)
+}
+
+func TestStartupProbeReconciled(t *testing.T) {
+	scheme := runtime.NewScheme()
+	require.NoError(t, clientgoscheme.AddToScheme(scheme))
+	require.NoError(t, llamav1alpha1.AddToScheme(scheme))
+
+	instance := &llamav1alpha1.LlamaStackDistribution{
+		ObjectMeta: metav1.ObjectMeta{Name: "probe", Namespace: "default", UID: "probe-uid"},
+		Spec: llamav1alpha1.LlamaStackDistributionSpec{
+			Server: llamav1alpha1.ServerSpec{
+				Distribution: llamav1alpha1.DistributionType{Name: "starter"},
+			},
+		},
+	}
+	r := &LlamaStackDistributionReconciler{
+		Client: fake.NewClientBuilder().WithScheme(scheme).
+			WithRESTMapper(testrestmapper.TestOnlyStaticRESTMapper(scheme)).
+			WithObjects(instance).Build(),
+		Scheme:      scheme,
+		ClusterInfo: setupTestClusterInfo(map[string]string{"starter": "docker.io/llamastack/distribution-starter:latest"}),
+	}
+	getProbe := func() *corev1.Probe {
+		t.Helper()
+		require.NoError(t, r.reconcileResources(t.Context(), instance))
+		deployment := &appsv1.Deployment{}
+		require.NoError(t, r.Get(t.Context(), client.ObjectKeyFromObject(instance), deployment))
+		require.Len(t, deployment.Spec.Template.Spec.Containers, 1)
+		probe := deployment.Spec.Template.Spec.Containers[0].StartupProbe
+		require.NotNil(t, probe)
+		return probe
+	}
+
+	// The default gives the server several minutes to load before a restart.
+	probe := getProbe()
+	assert.Equal(t, int32(startupProbeFailureThreshold), probe.FailureThreshold)
+	assert.GreaterOrEqual(t, probe.InitialDelaySeconds+probe.FailureThreshold*probe.PeriodSeconds, int32(300))
+
+	// Tuning on the CR reaches the Deployment.
+	instance.Spec.Server.StartupProbe = &llamav1alpha1.StartupProbeSpec{
+		FailureThreshold: int32Ptr(90),
+		PeriodSeconds:    int32Ptr(20),
+	}
+	probe = getProbe()
+	assert.Equal(t, int32(90), probe.FailureThreshold)
+	assert.Equal(t, int32(20), probe.PeriodSeconds)
+}
+
+func TestExtraArgs(t *testing.T) {
+	tests := []struct {
+		name         string
+		command      []string
+		args         []string
+		extraArgs    []string
+		userConfig   *llamav1alpha1.UserConfigSpec
+		expectedArgs []string
+	}{
+		{
+			name:         "extra args are appended to a custom command",
+			command:      []string{"/app/entrypoint.sh"},
+			extraArgs:    []string{"--disable-ipv6"},
+			expectedArgs: []string{"--disable-ipv6"},
+		},
+		{
+			name:         "full args override ignores extra args",
+			args:         []string{"--port", "9000"},
+			extraArgs:    []string{"--disable-ipv6"},
+			expectedArgs: []string{"--port", "9000"},
+		},
+		{
+			name:         "extra args reach the startup script as positional args",
+			extraArgs:    []string{"--disable-ipv6"},
+			userConfig:   &llamav1alpha1.UserConfigSpec{ConfigMapName: "custom-config"},
+			expectedArgs: []string{startupScriptName, "--disable-ipv6"},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			instance := &llamav1alpha1.LlamaStackDistribution{
+				Spec: llamav1alpha1.LlamaStackDistributionSpec{
+					Server: llamav1alpha1.ServerSpec{
+						ContainerSpec: llamav1alpha1.ContainerSpec{
+							Command:   tc.command,
+							Args:      tc.args,
+							ExtraArgs: tc.extraArgs,
+						},
+						UserConfig: tc.userConfig,
+					},
+				},
+			}
+
+			require.NoError(t, validateExtraArgs(instance))
+			container := buildContainerSpec(t.Context(), nil, instance, "test-image:latest")
+
+			assert.Equal(t, tc.expectedArgs, container.Args)
+		})
+	}
+
+	t.Run("extra args without userConfig are rejected", func(t *testing.T) {
+		instance := &llamav1alpha1.LlamaStackDistribution{
+			Spec: llamav1alpha1.LlamaStackDistributionSpec{
+				Server: llamav1alpha1.ServerSpec{
+					ContainerSpec: llamav1alpha1.ContainerSpec{ExtraArgs: []string{"--disable-ipv6"}},
+				},
+			},
+		}
+
+		err := validateExtraArgs(instance)
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "requires userConfig")
+	})
+}
+
+func TestDeployedWorkers(t *testing.T) {
+	tests := []struct {
+		name     string
+		workers  *int32
+		env      []corev1.EnvVar
+		expected int32
+	}{
+		{
+			name:     "defaulted",
+			expected: 1,
+		},
+		{
+			name:     "explicit",
+			workers:  int32Ptr(4),
+			expected: 4,
+		},
+		{
+			name:     "env override wins",
+			workers:  int32Ptr(4),
+			env:      []corev1.EnvVar{{Name: "LLS_WORKERS", Value: "8"}},
+			expected: 8,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			instance := &llamav1alpha1.LlamaStackDistribution{
+				Spec: llamav1alpha1.LlamaStackDistributionSpec{
+					Server: llamav1alpha1.ServerSpec{
+						Workers:       tc.workers,
+						ContainerSpec: llamav1alpha1.ContainerSpec{Env: tc.env},
+					},
+				},
+			}
+			deployment := &appsv1.Deployment{
+				Spec: appsv1.DeploymentSpec{
+					Template: corev1.PodTemplateSpec{
+						Spec: corev1.PodSpec{
+							Containers: []corev1.Container{
+								buildContainerSpec(t.Context(), nil, instance, "test-image:latest"),
+							},
+						},
+					},
+				},
+			}
+
+			assert.Equal(t, tc.expected, getDeployedWorkers(deployment, getContainerName(instance)))
+		})
+	}
+}
+
+func TestInjectedEnvVarNames(t *testing.T) {
+	secretEnv := func(name, secret string) corev1.EnvVar {
+		return corev1.EnvVar{
+			Name: name,
+			ValueFrom: &corev1.EnvVarSource{
+				SecretKeyRef: &corev1.SecretKeySelector{
+					LocalObjectReference: corev1.LocalObjectReference{Name: secret},
+					Key:                  "api-key",
+				},
+			},
+		}
+	}
+	instance := &llamav1alpha1.LlamaStackDistribution{
+		Spec: llamav1alpha1.LlamaStackDistributionSpec{
+			Server: llamav1alpha1.ServerSpec{
+				Proxy: &llamav1alpha1.ProxySpec{HTTPSProxy: "http://proxy.example.com:3128"},
+				ContainerSpec: llamav1alpha1.ContainerSpec{
+					Env: []corev1.EnvVar{
+						secretEnv("OPENAI_API_KEY", "openai"),
+						secretEnv("VLLM_API_TOKEN", "vllm"),
+					},
+				},
+			},
+		},
+	}
+	deployment := &appsv1.Deployment{
+		Spec: appsv1.DeploymentSpec{
+			Template: corev1.PodTemplateSpec{
+				Spec: corev1.PodSpec{
+					Containers: []corev1.Container{
+						buildContainerSpec(t.Context(), nil, instance, "test-image:latest"),
+					},
+				},
+			},
+		},
+	}
+
+	assert.Equal(t,
+		[]string{"HF_HOME", "LLS_WORKERS", "LLS_PORT", "LLAMA_STACK_CONFIG", "HTTPS_PROXY"},
+		getInjectedEnvVarNames(deployment, instance))
+}
+
+func TestGetReferencedSecretsHash(t *testing.T) {
+	secret := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Name: "provider-credentials", Namespace: "default"},
+		Data: map[string][]byte{
+			"api-key": []byte("initial"),
+			"unused":  []byte("ignored"),
+		},
+	}
+	instance := &llamav1alpha1.LlamaStackDistribution{
+		ObjectMeta: metav1.ObjectMeta{Name: "secret-hash", Namespace: "default"},
+		Spec: llamav1alpha1.LlamaStackDistributionSpec{
+			Server: llamav1alpha1.ServerSpec{
+				ContainerSpec: llamav1alpha1.ContainerSpec{
+					Env: []corev1.EnvVar{{
+						Name: "PROVIDER_API_KEY",
+						ValueFrom: &corev1.EnvVarSource{
+							SecretKeyRef: &corev1.SecretKeySelector{
+								LocalObjectReference: corev1.LocalObjectReference{Name: secret.Name},
+								Key:                  "api-key",
+							},
+						},
+					}},
+				},
+			},
+		},
+	}
+	r := &LlamaStackDistributionReconciler{
+		Client: fake.NewClientBuilder().WithScheme(clientgoscheme.Scheme).WithObjects(secret).Build(),
+	}
+	hash := func() string {
+		t.Helper()
+		value, err := r.getReferencedSecretsHash(t.Context(), instance)
+		require.NoError(t, err)
+		return value
+	}
+
+	initial := hash()
+	require.NotEmpty(t, initial)
+
+	// Metadata writes and unreferenced keys bump the resourceVersion but not the hash.
+	secret.Labels = map[string]string{"team": "platform"}
+	secret.Data["unused"] = []byte("changed")
+	require.NoError(t, r.Update(t.Context(), secret))
+	assert.Equal(t, initial, hash())
+
+	// Rotating the referenced value changes the hash.
+	secret.Data["api-key"] = []byte("rotated")
+	require.NoError(t, r.Update(t.Context(), secret))
+	assert.NotEqual(t, initial, hash())
+}
+
+func TestAutoTuneThreads(t *testing.T) {
+	envValue := func(container corev1.Container, name string) string {
+		value := ""
+		for _, env := range container.Env {
+			if env.Name == name {
+				value = env.Value
+			}
+		}
+		return value
+	}
+
+	tests := []struct {
+		name        string
+		cpuLimit    string
+		env         []corev1.EnvVar
+		expectedOMP string
+		expectedGo  string
+	}{
+		{
+			name:        "derived from an 8 core limit",
+			cpuLimit:    "8",
+			expectedOMP: "8",
+			expectedGo:  "8",
+		},
+		{
+			name:        "fractional limit rounds up",
+			cpuLimit:    "1500m",
+			expectedOMP: "2",
+			expectedGo:  "2",
+		},
+		{
+			name:        "user env wins",
+			cpuLimit:    "8",
+			env:         []corev1.EnvVar{{Name: "OMP_NUM_THREADS", Value: "4"}},
+			expectedOMP: "4",
+			expectedGo:  "8",
+		},
+		{
+			name: "no cpu limit",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			resources := corev1.ResourceRequirements{}
+			if tc.cpuLimit != "" {
+				resources.Limits = corev1.ResourceList{corev1.ResourceCPU: resource.MustParse(tc.cpuLimit)}
+			}
+			instance := &llamav1alpha1.LlamaStackDistribution{
+				Spec: llamav1alpha1.LlamaStackDistributionSpec{
+					Server: llamav1alpha1.ServerSpec{
+						ContainerSpec: llamav1alpha1.ContainerSpec{
+							AutoTuneThreads: true,
+							Resources:       resources,
+							Env:             tc.env,
+						},
+					},
+				},
+			}
+
+			container := buildContainerSpec(t.Context(), nil, instance, "test-image:latest")
+
+			assert.Equal(t, tc.expectedOMP, envValue(container, "OMP_NUM_THREADS"))
+			assert.Equal(t, tc.expectedGo, envValue(container, "GOMAXPROCS"))
+		})
+	}
+}
+
 func TestValidateConfigMapKeys(t *testing.T) {
 	tests := []struct {
 		name        string
@@ -856,6 +1859,39 @@ func TestDefaultSchedulingAppliedWhenReplicasGreaterThanOne(t *testing.T) {
 	}, keys)
 }
 
+func TestDefaultAntiAffinityReplacedByUserAffinity(t *testing.T) {
+	userAffinity := &corev1.Affinity{
+		NodeAffinity: &corev1.NodeAffinity{
+			RequiredDuringSchedulingIgnoredDuringExecution: &corev1.NodeSelector{
+				NodeSelectorTerms: []corev1.NodeSelectorTerm{{
+					MatchExpressions: []corev1.NodeSelectorRequirement{{
+						Key:      "node-role.kubernetes.io/gpu",
+						Operator: corev1.NodeSelectorOpExists,
+					}},
+				}},
+			},
+		},
+	}
+	instance := &llamav1alpha1.LlamaStackDistribution{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "custom-affinity",
+			Namespace: "default",
+		},
+		Spec: llamav1alpha1.LlamaStackDistributionSpec{
+			Replicas: 3,
+			Server: llamav1alpha1.ServerSpec{
+				PodOverrides: &llamav1alpha1.PodOverrides{Affinity: userAffinity},
+			},
+		},
+	}
+
+	podSpec := configurePodStorage(t.Context(), nil, instance, corev1.Container{})
+
+	require.NotNil(t, podSpec.Affinity)
+	assert.Equal(t, userAffinity.NodeAffinity, podSpec.Affinity.NodeAffinity)
+	assert.Nil(t, podSpec.Affinity.PodAntiAffinity, "default anti-affinity should not be merged into a user affinity")
+}
+
 func TestDefaultSchedulingSkippedForSingleReplica(t *testing.T) {
 	instance := &llamav1alpha1.LlamaStackDistribution{
 		ObjectMeta: metav1.ObjectMeta{
@@ -1038,18 +2074,19 @@ func TestBuildHPASpec(t *testing.T) {
 }
 
 // newDefaultStartupProbe returns a Kubernetes HTTP readiness probe that checks
-// the "/v1/health" endpoint on the given port using default timing and
+// the "/v1/health" endpoint on the named server port using default timing and
 // threshold settings.
-func newDefaultStartupProbe(port int32) *corev1.Probe {
+func newDefaultStartupProbe() *corev1.Probe {
 	return &corev1.Probe{
 		ProbeHandler: corev1.ProbeHandler{
 			HTTPGet: &corev1.HTTPGetAction{
 				Path: "/v1/health",
-				Port: intstr.FromInt(int(port)),
+				Port: intstr.FromString(llamav1alpha1.DefaultContainerPortName),
 			},
 		},
 		InitialDelaySeconds: startupProbeInitialDelaySeconds,
 		TimeoutSeconds:      startupProbeTimeoutSeconds,
+		PeriodSeconds:       startupProbePeriodSeconds,
 		FailureThreshold:    startupProbeFailureThreshold,
 		SuccessThreshold:    startupProbeSuccessThreshold,
 	}
@@ -0,0 +1,256 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controllers
+
+import (
+	"context"
+	"fmt"
+	"maps"
+
+	llamav1alpha1 "github.com/llamastack/llama-stack-k8s-operator/api/v1alpha1"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+)
+
+const (
+	// CanaryNameSuffix is the suffix for the canary Deployment name.
+	CanaryNameSuffix = "-canary"
+	// RolloutTrackLabelKey distinguishes canary pods from primary pods.
+	RolloutTrackLabelKey = "llamastack.io/track"
+	// RolloutTrackCanary is the track label value set on canary pods.
+	RolloutTrackCanary = "canary"
+	// CanaryLabelValue replaces the app label on canary pods so the immutable
+	// primary Deployment selector does not match them.
+	CanaryLabelValue = llamav1alpha1.DefaultLabelValue + CanaryNameSuffix
+)
+
+// hasCanary checks if the instance requests a canary Deployment.
+func hasCanary(instance *llamav1alpha1.LlamaStackDistribution) bool {
+	return instance.Spec.Rollout != nil && instance.Spec.Rollout.Canary != nil
+}
+
+// canaryInstance returns a copy of the instance that runs with the canary config.
+func canaryInstance(instance *llamav1alpha1.LlamaStackDistribution) *llamav1alpha1.LlamaStackDistribution {
+	canary := instance.DeepCopy()
+	userConfig := instance.Spec.Rollout.Canary.UserConfig
+	canary.Spec.Server.UserConfig = &userConfig
+	return canary
+}
+
+// promoteCanary handles the promote-canary annotation: the canary userConfig becomes
+// the primary one and the canary is removed, which deletes the canary Deployment.
+func (r *LlamaStackDistributionReconciler) promoteCanary(ctx context.Context, instance *llamav1alpha1.LlamaStackDistribution) error {
+	if _, ok := instance.Annotations[llamav1alpha1.PromoteCanaryAnnotationKey]; !ok {
+		return nil
+	}
+
+	patch := client.MergeFrom(instance.DeepCopy())
+	delete(instance.Annotations, llamav1alpha1.PromoteCanaryAnnotationKey)
+	promoted := hasCanary(instance)
+	if promoted {
+		userConfig := instance.Spec.Rollout.Canary.UserConfig
+		instance.Spec.Server.UserConfig = &userConfig
+		instance.Spec.Rollout.Canary = nil
+		if *instance.Spec.Rollout == (llamav1alpha1.RolloutSpec{}) {
+			instance.Spec.Rollout = nil
+		}
+	}
+	if err := r.Patch(ctx, instance, patch); err != nil {
+		return fmt.Errorf("failed to promote canary: %w", err)
+	}
+
+	if !promoted {
+		log.FromContext(ctx).Info("Ignoring promote-canary annotation as rollout.canary is not set")
+		return nil
+	}
+	log.FromContext(ctx).Info("Promoted canary config to the primary Deployment")
+	if r.Recorder != nil {
+		r.Recorder.Event(instance, corev1.EventTypeNormal, ReasonCanaryPromoted, "Canary config promoted to the primary Deployment")
+	}
+	return nil
+}
+
+// reconcileCanaryDeployment creates, updates, or deletes the canary Deployment
+// based on the rollout.canary setting.
+func (r *LlamaStackDistributionReconciler) reconcileCanaryDeployment(
+	ctx context.Context,
+	instance *llamav1alpha1.LlamaStackDistribution,
+) error {
+	logger := log.FromContext(ctx)
+	canaryName := instance.Name + CanaryNameSuffix
+
+	existing := &appsv1.Deployment{}
+	err := r.Get(ctx, types.NamespacedName{Name: canaryName, Namespace: instance.Namespace}, existing)
+	if err != nil && !k8serrors.IsNotFound(err) {
+		return fmt.Errorf("failed to get canary Deployment: %w", err)
+	}
+	existsAlready := err == nil
+
+	if existsAlready && !metav1.IsControlledBy(existing, instance) {
+		logger.V(1).Info("Canary Deployment not owned by this instance, skipping", "name", canaryName)
+		return nil
+	}
+
+	if !hasCanary(instance) {
+		if !existsAlready {
+			return nil
+		}
+		logger.Info("Deleting canary Deployment as rollout.canary is not set", "name", canaryName)
+		if err := r.Delete(ctx, existing); err != nil && !k8serrors.IsNotFound(err) {
+			return fmt.Errorf("failed to delete canary Deployment: %w", err)
+		}
+		return nil
+	}
+
+	primary := &appsv1.Deployment{}
+	if err := r.Get(ctx, types.NamespacedName{Name: instance.Name, Namespace: instance.Namespace}, primary); err != nil {
+		return fmt.Errorf("failed to get primary Deployment for canary: %w", err)
+	}
+
+	canary, err := r.buildCanaryDeployment(ctx, instance, primary)
+	if err != nil {
+		return err
+	}
+
+	// The selector is immutable; a canary created with an older selector that
+	// overlaps the primary one is replaced.
+	if existsAlready && !equality.Semantic.DeepEqual(existing.Spec.Selector, canary.Spec.Selector) {
+		logger.Info("Recreating canary Deployment with the current selector", "name", canaryName)
+		if err := r.Delete(ctx, existing); err != nil && !k8serrors.IsNotFound(err) {
+			return fmt.Errorf("failed to delete canary Deployment: %w", err)
+		}
+		existsAlready = false
+	}
+	if !existsAlready {
+		logger.Info("Creating canary Deployment", "name", canaryName)
+	}
+
+	// Server-side apply leaves the object untouched when nothing changed.
+	canary.SetGroupVersionKind(appsv1.SchemeGroupVersion.WithKind("Deployment"))
+	if err := r.Patch(ctx, canary, client.Apply, client.ForceOwnership, client.FieldOwner("llama-stack-operator")); err != nil {
+		return fmt.Errorf("failed to apply canary Deployment: %w", err)
+	}
+
+	return nil
+}
+
+// useEphemeralStorage swaps the PVC-backed storage volume for an emptyDir so the
+// canary never attaches the primary claim or shares its sqlite files.
+func useEphemeralStorage(podSpec *corev1.PodSpec) {
+	for i := range podSpec.Volumes {
+		volume := &podSpec.Volumes[i]
+		if volume.Name == "lls-storage" && volume.PersistentVolumeClaim != nil {
+			volume.VolumeSource = corev1.VolumeSource{EmptyDir: &corev1.EmptyDirVolumeSource{}}
+		}
+	}
+}
+
+// canaryReplicas returns the canary pod count. With a weight, the canary is sized to
+// hold that share of the pods behind the Service, rounded up to at least one pod.
+func canaryReplicas(canary *llamav1alpha1.CanarySpec, primary *appsv1.Deployment) int32 {
+	if canary.Weight == nil {
+		return canary.Replicas
+	}
+
+	primaryReplicas := int32(1)
+	if primary.Spec.Replicas != nil {
+		primaryReplicas = *primary.Spec.Replicas
+	}
+	weight := *canary.Weight
+	replicas := (primaryReplicas*weight + 100 - weight - 1) / (100 - weight)
+	return max(replicas, 1)
+}
+
+// buildCanaryDeployment derives the canary Deployment from the rendered primary
+// Deployment, swapping in the canary config and track label. Canary pods run on
+// ephemeral storage.
+func (r *LlamaStackDistributionReconciler) buildCanaryDeployment(
+	ctx context.Context,
+	instance *llamav1alpha1.LlamaStackDistribution,
+	primary *appsv1.Deployment,
+) (*appsv1.Deployment, error) {
+	canaryInst := canaryInstance(instance)
+
+	image, err := r.resolveImage(canaryInst.Spec.Server.Distribution)
+	if err != nil {
+		return nil, err
+	}
+
+	configMapHash, err := r.getConfigMapHash(ctx, canaryInst)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get canary ConfigMap hash: %w", err)
+	}
+
+	container := buildContainerSpec(ctx, r, canaryInst, image)
+	podSpec := configurePodStorage(ctx, r, canaryInst, container)
+	useEphemeralStorage(&podSpec)
+
+	spec := primary.Spec.DeepCopy()
+	replicas := canaryReplicas(instance.Spec.Rollout.Canary, primary)
+	spec.Replicas = &replicas
+
+	// Canary pods carry their own app label: the primary selector leaves them
+	// alone and the Service reaches both through the instance label.
+	spec.Selector = &metav1.LabelSelector{MatchLabels: map[string]string{
+		llamav1alpha1.DefaultLabelKey: CanaryLabelValue,
+		instanceLabelKey:              instance.Name,
+	}}
+
+	if spec.Template.Labels == nil {
+		spec.Template.Labels = map[string]string{}
+	}
+	maps.Copy(spec.Template.Labels, spec.Selector.MatchLabels)
+	spec.Template.Labels[RolloutTrackLabelKey] = RolloutTrackCanary
+
+	if spec.Template.Annotations == nil {
+		spec.Template.Annotations = map[string]string{}
+	}
+	spec.Template.Annotations["configmap.hash/user-config"] = configMapHash
+
+	// Only the containers and volumes depend on the user config; everything else
+	// follows the primary Deployment.
+	spec.Template.Spec.Containers = podSpec.Containers
+	spec.Template.Spec.Volumes = podSpec.Volumes
+
+	labels := maps.Clone(primary.Labels)
+	if labels == nil {
+		labels = map[string]string{}
+	}
+	labels[RolloutTrackLabelKey] = RolloutTrackCanary
+
+	canary := &appsv1.Deployment{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      instance.Name + CanaryNameSuffix,
+			Namespace: instance.Namespace,
+			Labels:    labels,
+		},
+		Spec: *spec,
+	}
+
+	if err := ctrl.SetControllerReference(instance, canary, r.Scheme); err != nil {
+		return nil, fmt.Errorf("failed to set controller reference: %w", err)
+	}
+
+	return canary, nil
+}
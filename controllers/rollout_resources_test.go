@@ -0,0 +1,120 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controllers_test
+
+import (
+	"testing"
+
+	llamav1alpha1 "github.com/llamastack/llama-stack-k8s-operator/api/v1alpha1"
+	"github.com/llamastack/llama-stack-k8s-operator/controllers"
+	"github.com/stretchr/testify/require"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/types"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/log/zap"
+)
+
+func TestCanaryDeployment(t *testing.T) {
+	ctrl.SetLogger(zap.New(zap.UseDevMode(true)))
+
+	namespace := createTestNamespace(t, "test-canary")
+
+	for _, name := range []string{"primary-config", "canary-config"} {
+		configMap := &corev1.ConfigMap{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      name,
+				Namespace: namespace.Name,
+			},
+			Data: map[string]string{
+				"config.yaml": "version: '2'\nimage_name: " + name,
+			},
+		}
+		require.NoError(t, k8sClient.Create(t.Context(), configMap))
+	}
+
+	instance := NewDistributionBuilder().
+		WithName("test-canary").
+		WithNamespace(namespace.Name).
+		WithUserConfig("primary-config").
+		WithStorage(&llamav1alpha1.StorageSpec{}).
+		Build()
+	instance.Spec.Rollout = &llamav1alpha1.RolloutSpec{
+		Canary: &llamav1alpha1.CanarySpec{
+			Replicas:   1,
+			UserConfig: llamav1alpha1.UserConfigSpec{ConfigMapName: "canary-config"},
+		},
+	}
+	require.NoError(t, k8sClient.Create(t.Context(), instance))
+	t.Cleanup(func() { _ = k8sClient.Delete(t.Context(), instance) })
+
+	ReconcileDistribution(t, instance, false)
+
+	primary := &appsv1.Deployment{}
+	waitForResource(t, k8sClient, instance.Namespace, instance.Name, primary)
+	canaryKey := types.NamespacedName{Name: instance.Name + controllers.CanaryNameSuffix, Namespace: instance.Namespace}
+	canary := &appsv1.Deployment{}
+	waitForResourceWithKey(t, k8sClient, canaryKey, canary)
+
+	// The canary runs the candidate config while the primary keeps its own.
+	require.Equal(t, "primary-config", findVolumeByName(t, primary, "user-config").ConfigMap.Name)
+	require.Equal(t, "canary-config", findVolumeByName(t, canary, "user-config").ConfigMap.Name)
+
+	// Canary pods carry their own app and track labels and are scaled independently.
+	require.Equal(t, controllers.CanaryLabelValue, canary.Spec.Selector.MatchLabels[llamav1alpha1.DefaultLabelKey])
+	require.Equal(t, controllers.RolloutTrackCanary, canary.Spec.Template.Labels[controllers.RolloutTrackLabelKey])
+	require.NotContains(t, primary.Spec.Template.Labels, controllers.RolloutTrackLabelKey)
+	require.Equal(t, int32(1), *canary.Spec.Replicas)
+	AssertResourceOwnedByInstance(t, canary, instance)
+
+	// The primary workload (and its PDB and HPA) must not count canary pods.
+	primarySelector, err := metav1.LabelSelectorAsSelector(primary.Spec.Selector)
+	require.NoError(t, err)
+	require.False(t, primarySelector.Matches(labels.Set(canary.Spec.Template.Labels)),
+		"primary selector should not match canary pods")
+
+	// The canary never mounts the primary claim.
+	require.NotNil(t, findVolumeByName(t, primary, "lls-storage").PersistentVolumeClaim)
+	require.NotNil(t, findVolumeByName(t, canary, "lls-storage").EmptyDir)
+
+	// Reconciling an unchanged canary does not write it again.
+	ReconcileDistribution(t, instance, false)
+	unchanged := &appsv1.Deployment{}
+	require.NoError(t, k8sClient.Get(t.Context(), canaryKey, unchanged))
+	require.Equal(t, canary.ResourceVersion, unchanged.ResourceVersion)
+
+	// Canary pods match the Service selector while the canary exists.
+	service := &corev1.Service{}
+	waitForResource(t, k8sClient, instance.Namespace, instance.Name+"-service", service)
+	for key, value := range service.Spec.Selector {
+		require.Equal(t, value, canary.Spec.Template.Labels[key], "canary pods should match Service selector %s", key)
+	}
+
+	// Removing the canary section deletes the canary Deployment.
+	require.NoError(t, k8sClient.Get(t.Context(), types.NamespacedName{Name: instance.Name, Namespace: instance.Namespace}, instance))
+	instance.Spec.Rollout = nil
+	require.NoError(t, k8sClient.Update(t.Context(), instance))
+	ReconcileDistribution(t, instance, false)
+
+	require.Eventually(t, func() bool {
+		err := k8sClient.Get(t.Context(), canaryKey, &appsv1.Deployment{})
+		return apierrors.IsNotFound(err)
+	}, testTimeout, testInterval, "canary Deployment should be deleted")
+}
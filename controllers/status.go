@@ -15,6 +15,10 @@ const (
 	ConditionTypeStorageReady = "StorageReady"
 	// ConditionTypeServiceReady indicates whether the service is ready.
 	ConditionTypeServiceReady = "ServiceReady"
+	// ConditionTypeDistributionChanged indicates whether the last reconcile rolled out a new distribution image.
+	ConditionTypeDistributionChanged = "DistributionChanged"
+	// ConditionTypeStorageResized indicates whether the PVC matches the requested storage size.
+	ConditionTypeStorageResized = "StorageResized"
 )
 
 // Condition reasons.
@@ -37,6 +41,18 @@ const (
 	ReasonServiceReady = "ServiceReady"
 	// ReasonServiceFailed indicates the service failed.
 	ReasonServiceFailed = "ServiceFailed"
+	// ReasonDistributionChanged indicates the distribution image changed.
+	ReasonDistributionChanged = "DistributionChanged"
+	// ReasonDistributionUnchanged indicates the distribution image is unchanged.
+	ReasonDistributionUnchanged = "DistributionUnchanged"
+	// ReasonStorageResized indicates the PVC was expanded to the requested storage size.
+	ReasonStorageResized = "StorageResized"
+	// ReasonStorageResizeFailed indicates the PVC could not be resized.
+	ReasonStorageResizeFailed = "StorageResizeFailed"
+	// ReasonStorageUpToDate indicates the PVC already matches the requested storage size.
+	ReasonStorageUpToDate = "StorageUpToDate"
+	// ReasonCanaryPromoted indicates the canary config replaced the primary config.
+	ReasonCanaryPromoted = "CanaryPromoted"
 )
 
 // Condition messages.
@@ -59,6 +75,12 @@ const (
 	MessageServiceReady = "Service is ready"
 	// MessageServiceFailed indicates the service failed.
 	MessageServiceFailed = "Service failed"
+	// MessageDistributionUnchanged indicates the distribution image is unchanged.
+	MessageDistributionUnchanged = "Distribution image unchanged"
+	// MessageStorageResized indicates the PVC was expanded to the requested storage size.
+	MessageStorageResized = "PVC was expanded to the requested storage size"
+	// MessageStorageUpToDate indicates the PVC already matches the requested storage size.
+	MessageStorageUpToDate = "PVC already matches the requested storage size"
 )
 
 // SetDeploymentReadyCondition sets the deployment ready condition.
@@ -137,6 +159,56 @@ func SetServiceReadyCondition(status *llamav1alpha1.LlamaStackDistributionStatus
 	SetCondition(status, condition)
 }
 
+// SetDistributionChangedCondition sets the distribution changed condition.
+func SetDistributionChangedCondition(status *llamav1alpha1.LlamaStackDistributionStatus, changed bool, message string) {
+	condition := metav1.Condition{
+		Type:               ConditionTypeDistributionChanged,
+		Status:             metav1.ConditionFalse,
+		Reason:             ReasonDistributionUnchanged,
+		Message:            MessageDistributionUnchanged,
+		LastTransitionTime: metav1.NewTime(metav1.Now().UTC()),
+	}
+
+	if changed {
+		condition.Status = metav1.ConditionTrue
+		condition.Reason = ReasonDistributionChanged
+		condition.Message = message
+	}
+
+	SetCondition(status, condition)
+}
+
+// SetStorageResizedCondition sets the storage resized condition.
+func SetStorageResizedCondition(status *llamav1alpha1.LlamaStackDistributionStatus, resized bool, message string) {
+	condition := metav1.Condition{
+		Type:               ConditionTypeStorageResized,
+		Status:             metav1.ConditionTrue,
+		Reason:             ReasonStorageResized,
+		Message:            MessageStorageResized,
+		LastTransitionTime: metav1.NewTime(metav1.Now().UTC()),
+	}
+
+	if !resized {
+		condition.Status = metav1.ConditionFalse
+		condition.Reason = ReasonStorageResizeFailed
+		condition.Message = message
+	}
+
+	SetCondition(status, condition)
+}
+
+// SetStorageUpToDateCondition marks the storage resized condition true without a resize,
+// for a PVC that matches the requested size after an earlier failure.
+func SetStorageUpToDateCondition(status *llamav1alpha1.LlamaStackDistributionStatus) {
+	SetCondition(status, metav1.Condition{
+		Type:               ConditionTypeStorageResized,
+		Status:             metav1.ConditionTrue,
+		Reason:             ReasonStorageUpToDate,
+		Message:            MessageStorageUpToDate,
+		LastTransitionTime: metav1.NewTime(metav1.Now().UTC()),
+	})
+}
+
 // SetCondition sets a condition in the status.
 func SetCondition(status *llamav1alpha1.LlamaStackDistributionStatus, condition metav1.Condition) {
 	// Initialize conditions if needed
@@ -0,0 +1,177 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controllers
+
+import (
+	"context"
+	"fmt"
+	"slices"
+
+	llamav1alpha1 "github.com/llamastack/llama-stack-k8s-operator/api/v1alpha1"
+	corev1 "k8s.io/api/core/v1"
+	storagev1 "k8s.io/api/storage/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+)
+
+// getStoragePVC reads the PVC backing the storage volume. Existing claims are not
+// labeled as managed by the operator, so they are read past the filtered cache.
+func (r *LlamaStackDistributionReconciler) getStoragePVC(ctx context.Context, instance *llamav1alpha1.LlamaStackDistribution, pvc *corev1.PersistentVolumeClaim) error {
+	key := types.NamespacedName{Name: instance.PVCName(), Namespace: instance.Namespace}
+	if instance.ManagesPVC() {
+		return r.Get(ctx, key, pvc)
+	}
+	return r.directGet(ctx, key, pvc)
+}
+
+// validateExistingClaim ensures a referenced existing PVC is present before the
+// Deployment is rolled out against it.
+func (r *LlamaStackDistributionReconciler) validateExistingClaim(ctx context.Context, instance *llamav1alpha1.LlamaStackDistribution) error {
+	if !instance.HasPersistentStorage() || instance.ManagesPVC() {
+		return nil
+	}
+
+	pvc := &corev1.PersistentVolumeClaim{}
+	if err := r.getStoragePVC(ctx, instance, pvc); err != nil {
+		if k8serrors.IsNotFound(err) {
+			return fmt.Errorf("failed to find existing PVC %s in namespace %s", instance.PVCName(), instance.Namespace)
+		}
+		return fmt.Errorf("failed to get existing PVC %s: %w", instance.PVCName(), err)
+	}
+	return nil
+}
+
+// reconcilePVCSize expands the server PVC when spec.server.storage.size grows.
+// PVCs are otherwise left untouched after creation, and shrinking is never attempted.
+func (r *LlamaStackDistributionReconciler) reconcilePVCSize(ctx context.Context, instance *llamav1alpha1.LlamaStackDistribution) error {
+	if !instance.ManagesPVC() || instance.Spec.Server.Storage.Size == nil {
+		return nil
+	}
+
+	pvc := &corev1.PersistentVolumeClaim{}
+	if err := r.getStoragePVC(ctx, instance, pvc); err != nil {
+		if k8serrors.IsNotFound(err) {
+			return nil
+		}
+		return fmt.Errorf("failed to get PVC: %w", err)
+	}
+
+	desired := *instance.Spec.Server.Storage.Size
+	current := pvc.Spec.Resources.Requests[corev1.ResourceStorage]
+
+	switch desired.Cmp(current) {
+	case 0:
+		// Nothing was resized; only clear a failure reported for an earlier size.
+		if IsConditionFalse(&instance.Status, ConditionTypeStorageResized) {
+			SetStorageUpToDateCondition(&instance.Status)
+		}
+		return nil
+	case -1:
+		SetStorageResizedCondition(&instance.Status, false,
+			fmt.Sprintf("cannot shrink PVC from %s to %s", current.String(), desired.String()))
+		return nil
+	}
+
+	allowed, reason, err := r.pvcAllowsExpansion(ctx, pvc)
+	if err != nil {
+		return err
+	}
+	if !allowed {
+		SetStorageResizedCondition(&instance.Status, false,
+			fmt.Sprintf("cannot expand PVC from %s to %s: %s", current.String(), desired.String(), reason))
+		return nil
+	}
+
+	patch := client.MergeFrom(pvc.DeepCopy())
+	if pvc.Spec.Resources.Requests == nil {
+		pvc.Spec.Resources.Requests = corev1.ResourceList{}
+	}
+	pvc.Spec.Resources.Requests[corev1.ResourceStorage] = desired
+	if err := r.Patch(ctx, pvc, patch); err != nil {
+		return fmt.Errorf("failed to expand PVC: %w", err)
+	}
+
+	log.FromContext(ctx).Info("Expanded PVC", "pvc", pvc.Name, "from", current.String(), "to", desired.String())
+	SetStorageResizedCondition(&instance.Status, true, MessageStorageResized)
+	return nil
+}
+
+// reconcilePVCRetention removes the instance's owner reference from the operator-created PVC
+// when storage.retainOnDelete is set, so the claim survives deletion of the instance. When it
+// is unset again, an unowned PVC labeled as managed by the operator is adopted back.
+func (r *LlamaStackDistributionReconciler) reconcilePVCRetention(ctx context.Context, instance *llamav1alpha1.LlamaStackDistribution) error {
+	if !instance.ManagesPVC() {
+		return nil
+	}
+
+	pvc := &corev1.PersistentVolumeClaim{}
+	if err := r.getStoragePVC(ctx, instance, pvc); err != nil {
+		if k8serrors.IsNotFound(err) {
+			return nil
+		}
+		return fmt.Errorf("failed to get PVC: %w", err)
+	}
+
+	patch := client.MergeFrom(pvc.DeepCopy())
+	if instance.Spec.Server.Storage.RetainOnDelete {
+		if !metav1.IsControlledBy(pvc, instance) {
+			return nil
+		}
+		pvc.OwnerReferences = slices.DeleteFunc(pvc.OwnerReferences, func(ref metav1.OwnerReference) bool {
+			return ref.UID == instance.UID
+		})
+		log.FromContext(ctx).Info("Removing owner reference from PVC to retain it on delete", "pvc", pvc.Name)
+	} else {
+		if metav1.GetControllerOf(pvc) != nil || pvc.Labels["app.kubernetes.io/managed-by"] != "llama-stack-operator" {
+			return nil
+		}
+		if err := ctrl.SetControllerReference(instance, pvc, r.Scheme); err != nil {
+			return fmt.Errorf("failed to set controller reference on PVC: %w", err)
+		}
+		log.FromContext(ctx).Info("Adopting retained PVC", "pvc", pvc.Name)
+	}
+
+	if err := r.Patch(ctx, pvc, patch); err != nil {
+		return fmt.Errorf("failed to patch PVC owner references: %w", err)
+	}
+	return nil
+}
+
+// pvcAllowsExpansion reports whether the PVC's storage class supports volume expansion,
+// with a reason when it does not.
+func (r *LlamaStackDistributionReconciler) pvcAllowsExpansion(ctx context.Context, pvc *corev1.PersistentVolumeClaim) (bool, string, error) {
+	if pvc.Spec.StorageClassName == nil || *pvc.Spec.StorageClassName == "" {
+		return false, "PVC has no storage class", nil
+	}
+
+	storageClass := &storagev1.StorageClass{}
+	if err := r.directGet(ctx, types.NamespacedName{Name: *pvc.Spec.StorageClassName}, storageClass); err != nil {
+		if k8serrors.IsNotFound(err) {
+			return false, fmt.Sprintf("storage class %s not found", *pvc.Spec.StorageClassName), nil
+		}
+		return false, "", fmt.Errorf("failed to get storage class %s: %w", *pvc.Spec.StorageClassName, err)
+	}
+
+	if storageClass.AllowVolumeExpansion == nil || !*storageClass.AllowVolumeExpansion {
+		return false, fmt.Sprintf("storage class %s does not allow volume expansion", storageClass.Name), nil
+	}
+	return true, "", nil
+}
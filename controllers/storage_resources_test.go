@@ -0,0 +1,256 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controllers
+
+import (
+	"testing"
+
+	llamav1alpha1 "github.com/llamastack/llama-stack-k8s-operator/api/v1alpha1"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	storagev1 "k8s.io/api/storage/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta/testrestmapper"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+func newResizeTestObjects(requestedSize string, allowExpansion bool) (*llamav1alpha1.LlamaStackDistribution, *corev1.PersistentVolumeClaim, *storagev1.StorageClass) {
+	size := resource.MustParse(requestedSize)
+	instance := &llamav1alpha1.LlamaStackDistribution{
+		ObjectMeta: metav1.ObjectMeta{Name: "resize", Namespace: "default"},
+		Spec: llamav1alpha1.LlamaStackDistributionSpec{
+			Server: llamav1alpha1.ServerSpec{
+				Storage: &llamav1alpha1.StorageSpec{Size: &size},
+			},
+		},
+	}
+	storageClassName := "standard"
+	pvc := &corev1.PersistentVolumeClaim{
+		ObjectMeta: metav1.ObjectMeta{Name: "resize-pvc", Namespace: "default"},
+		Spec: corev1.PersistentVolumeClaimSpec{
+			StorageClassName: &storageClassName,
+			Resources: corev1.VolumeResourceRequirements{
+				Requests: corev1.ResourceList{corev1.ResourceStorage: resource.MustParse("10Gi")},
+			},
+		},
+	}
+	storageClass := &storagev1.StorageClass{
+		ObjectMeta:           metav1.ObjectMeta{Name: storageClassName},
+		Provisioner:          "example.com/provisioner",
+		AllowVolumeExpansion: &allowExpansion,
+	}
+	return instance, pvc, storageClass
+}
+
+func getPVCStorageRequest(t *testing.T, r *LlamaStackDistributionReconciler) resource.Quantity {
+	t.Helper()
+	pvc := &corev1.PersistentVolumeClaim{}
+	require.NoError(t, r.Get(t.Context(), types.NamespacedName{Name: "resize-pvc", Namespace: "default"}, pvc))
+	return pvc.Spec.Resources.Requests[corev1.ResourceStorage]
+}
+
+func TestReconcilePVCSize_Expands(t *testing.T) {
+	instance, pvc, storageClass := newResizeTestObjects("20Gi", true)
+	r := &LlamaStackDistributionReconciler{
+		Client: fake.NewClientBuilder().WithScheme(clientgoscheme.Scheme).WithObjects(pvc, storageClass).Build(),
+	}
+
+	require.NoError(t, r.reconcilePVCSize(t.Context(), instance))
+
+	request := getPVCStorageRequest(t, r)
+	assert.Equal(t, "20Gi", request.String())
+	assert.True(t, IsConditionTrue(&instance.Status, ConditionTypeStorageResized))
+	assert.Equal(t, ReasonStorageResized, GetCondition(&instance.Status, ConditionTypeStorageResized).Reason)
+}
+
+func TestReconcilePVCSize_Unchanged(t *testing.T) {
+	instance, pvc, storageClass := newResizeTestObjects("10Gi", true)
+	r := &LlamaStackDistributionReconciler{
+		Client: fake.NewClientBuilder().WithScheme(clientgoscheme.Scheme).WithObjects(pvc, storageClass).Build(),
+	}
+
+	// A PVC that already matches is not reported as resized.
+	require.NoError(t, r.reconcilePVCSize(t.Context(), instance))
+	assert.Nil(t, GetCondition(&instance.Status, ConditionTypeStorageResized))
+
+	// An earlier failure is cleared once the size matches again.
+	SetStorageResizedCondition(&instance.Status, false, "cannot shrink PVC from 10Gi to 5Gi")
+	require.NoError(t, r.reconcilePVCSize(t.Context(), instance))
+	require.True(t, IsConditionTrue(&instance.Status, ConditionTypeStorageResized))
+	assert.Equal(t, ReasonStorageUpToDate, GetCondition(&instance.Status, ConditionTypeStorageResized).Reason)
+}
+
+func TestReconcilePVCSize_RejectsShrink(t *testing.T) {
+	instance, pvc, storageClass := newResizeTestObjects("5Gi", true)
+	r := &LlamaStackDistributionReconciler{
+		Client: fake.NewClientBuilder().WithScheme(clientgoscheme.Scheme).WithObjects(pvc, storageClass).Build(),
+	}
+
+	require.NoError(t, r.reconcilePVCSize(t.Context(), instance))
+
+	request := getPVCStorageRequest(t, r)
+	assert.Equal(t, "10Gi", request.String())
+	require.True(t, IsConditionFalse(&instance.Status, ConditionTypeStorageResized))
+	assert.Contains(t, GetCondition(&instance.Status, ConditionTypeStorageResized).Message, "cannot shrink PVC")
+}
+
+func TestReconcilePVCSize_ExpansionNotSupported(t *testing.T) {
+	instance, pvc, storageClass := newResizeTestObjects("20Gi", false)
+	r := &LlamaStackDistributionReconciler{
+		Client: fake.NewClientBuilder().WithScheme(clientgoscheme.Scheme).WithObjects(pvc, storageClass).Build(),
+	}
+
+	require.NoError(t, r.reconcilePVCSize(t.Context(), instance))
+
+	request := getPVCStorageRequest(t, r)
+	assert.Equal(t, "10Gi", request.String())
+	require.True(t, IsConditionFalse(&instance.Status, ConditionTypeStorageResized))
+	assert.Contains(t, GetCondition(&instance.Status, ConditionTypeStorageResized).Message, "does not allow volume expansion")
+}
+
+func TestValidateExistingClaim(t *testing.T) {
+	instance := &llamav1alpha1.LlamaStackDistribution{
+		ObjectMeta: metav1.ObjectMeta{Name: "existing", Namespace: "default"},
+		Spec: llamav1alpha1.LlamaStackDistributionSpec{
+			Server: llamav1alpha1.ServerSpec{
+				Storage: &llamav1alpha1.StorageSpec{ExistingClaimName: "shared-models"},
+			},
+		},
+	}
+
+	t.Run("missing claim is rejected", func(t *testing.T) {
+		r := &LlamaStackDistributionReconciler{
+			Client: fake.NewClientBuilder().WithScheme(clientgoscheme.Scheme).Build(),
+		}
+
+		err := r.validateExistingClaim(t.Context(), instance)
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "failed to find existing PVC shared-models")
+	})
+
+	t.Run("present claim is accepted", func(t *testing.T) {
+		pvc := &corev1.PersistentVolumeClaim{
+			ObjectMeta: metav1.ObjectMeta{Name: "shared-models", Namespace: "default"},
+		}
+		r := &LlamaStackDistributionReconciler{
+			Client: fake.NewClientBuilder().WithScheme(clientgoscheme.Scheme).WithObjects(pvc).Build(),
+		}
+
+		require.NoError(t, r.validateExistingClaim(t.Context(), instance))
+	})
+
+	t.Run("missing claim blocks the Deployment", func(t *testing.T) {
+		scheme := runtime.NewScheme()
+		require.NoError(t, clientgoscheme.AddToScheme(scheme))
+		require.NoError(t, llamav1alpha1.AddToScheme(scheme))
+		owned := instance.DeepCopy()
+		owned.UID = "existing-uid"
+		owned.Spec.Server.Distribution.Name = "starter"
+		r := &LlamaStackDistributionReconciler{
+			Client: fake.NewClientBuilder().WithScheme(scheme).
+				WithRESTMapper(testrestmapper.TestOnlyStaticRESTMapper(scheme)).
+				WithObjects(owned).Build(),
+			Scheme:      scheme,
+			ClusterInfo: setupTestClusterInfo(map[string]string{"starter": "docker.io/llamastack/distribution-starter:latest"}),
+		}
+
+		require.Error(t, r.reconcileResources(t.Context(), owned))
+
+		err := r.Get(t.Context(), types.NamespacedName{Name: "existing", Namespace: "default"}, &appsv1.Deployment{})
+		assert.True(t, k8serrors.IsNotFound(err), "no Deployment should mount a missing claim")
+	})
+}
+
+func TestReconcilePVCRetention(t *testing.T) {
+	scheme := runtime.NewScheme()
+	require.NoError(t, clientgoscheme.AddToScheme(scheme))
+	require.NoError(t, llamav1alpha1.AddToScheme(scheme))
+
+	newInstance := func(retain bool) *llamav1alpha1.LlamaStackDistribution {
+		return &llamav1alpha1.LlamaStackDistribution{
+			ObjectMeta: metav1.ObjectMeta{Name: "retain", Namespace: "default", UID: "retain-uid"},
+			Spec: llamav1alpha1.LlamaStackDistributionSpec{
+				Server: llamav1alpha1.ServerSpec{
+					Storage: &llamav1alpha1.StorageSpec{RetainOnDelete: retain},
+				},
+			},
+		}
+	}
+	newPVC := func(owner *llamav1alpha1.LlamaStackDistribution) *corev1.PersistentVolumeClaim {
+		pvc := &corev1.PersistentVolumeClaim{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "retain-pvc",
+				Namespace: "default",
+				Labels:    map[string]string{"app.kubernetes.io/managed-by": "llama-stack-operator"},
+			},
+		}
+		if owner != nil {
+			require.NoError(t, ctrl.SetControllerReference(owner, pvc, scheme))
+		}
+		return pvc
+	}
+	getPVC := func(t *testing.T, r *LlamaStackDistributionReconciler) *corev1.PersistentVolumeClaim {
+		t.Helper()
+		pvc := &corev1.PersistentVolumeClaim{}
+		require.NoError(t, r.Get(t.Context(), types.NamespacedName{Name: "retain-pvc", Namespace: "default"}, pvc))
+		return pvc
+	}
+
+	t.Run("retention removes the owner reference", func(t *testing.T) {
+		instance := newInstance(true)
+		r := &LlamaStackDistributionReconciler{
+			Client: fake.NewClientBuilder().WithScheme(scheme).WithObjects(newPVC(instance)).Build(),
+			Scheme: scheme,
+		}
+
+		require.NoError(t, r.reconcilePVCRetention(t.Context(), instance))
+
+		assert.Empty(t, getPVC(t, r).OwnerReferences)
+	})
+
+	t.Run("default keeps the owner reference", func(t *testing.T) {
+		instance := newInstance(false)
+		r := &LlamaStackDistributionReconciler{
+			Client: fake.NewClientBuilder().WithScheme(scheme).WithObjects(newPVC(instance)).Build(),
+			Scheme: scheme,
+		}
+
+		require.NoError(t, r.reconcilePVCRetention(t.Context(), instance))
+
+		assert.True(t, metav1.IsControlledBy(getPVC(t, r), instance))
+	})
+
+	t.Run("disabling retention adopts the PVC again", func(t *testing.T) {
+		instance := newInstance(false)
+		r := &LlamaStackDistributionReconciler{
+			Client: fake.NewClientBuilder().WithScheme(scheme).WithObjects(newPVC(nil)).Build(),
+			Scheme: scheme,
+		}
+
+		require.NoError(t, r.reconcilePVCRetention(t.Context(), instance))
+
+		assert.True(t, metav1.IsControlledBy(getPVC(t, r), instance))
+	})
+}
@@ -24,6 +24,7 @@ import (
 	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/intstr"
 	"k8s.io/client-go/kubernetes/scheme"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
@@ -111,6 +112,11 @@ func (b *DistributionBuilder) WithServiceAccountName(serviceAccountName string)
 	return b
 }
 
+func (b *DistributionBuilder) WithEnv(env ...corev1.EnvVar) *DistributionBuilder {
+	b.instance.Spec.Server.ContainerSpec.Env = append(b.instance.Spec.Server.ContainerSpec.Env, env...)
+	return b
+}
+
 func (b *DistributionBuilder) WithUserConfig(configMapName string) *DistributionBuilder {
 	b.instance.Spec.Server.UserConfig = &llamav1alpha1.UserConfigSpec{
 		ConfigMapName: configMapName,
@@ -220,10 +226,26 @@ func AssertServiceExposesDeployment(t *testing.T, service *corev1.Service, deplo
 
 	servicePort := service.Spec.Ports[0]
 	containerPort := deployment.Spec.Template.Spec.Containers[0].Ports[0].ContainerPort
-	require.Equal(t, servicePort.TargetPort.IntVal, containerPort,
+	require.Equal(t, resolveTargetPort(t, servicePort.TargetPort, deployment), containerPort,
 		"service target port should route to deployment container port")
 }
 
+// resolveTargetPort returns the port number a Service target port routes to, looking up
+// named ports on the deployment's first container.
+func resolveTargetPort(t *testing.T, targetPort intstr.IntOrString, deployment *appsv1.Deployment) int32 {
+	t.Helper()
+	if targetPort.Type == intstr.Int {
+		return targetPort.IntVal
+	}
+	for _, port := range deployment.Spec.Template.Spec.Containers[0].Ports {
+		if port.Name == targetPort.StrVal {
+			return port.ContainerPort
+		}
+	}
+	require.Failf(t, "unresolved target port", "no container port named %q", targetPort.StrVal)
+	return 0
+}
+
 func AssertNetworkPolicyProtectsDeployment(t *testing.T, networkPolicy *networkingv1.NetworkPolicy, deployment *appsv1.Deployment) {
 	t.Helper()
 
@@ -304,11 +326,24 @@ func AssertServiceAndDeploymentPortsAlign(t *testing.T, service *corev1.Service,
 	require.Len(t, deployment.Spec.Template.Spec.Containers, 1, "Deployment should have exactly one container")
 	require.Len(t, deployment.Spec.Template.Spec.Containers[0].Ports, 1, "Container should have exactly one port")
 
-	serviceTargetPort := service.Spec.Ports[0].TargetPort.IntVal
+	serviceTargetPort := resolveTargetPort(t, service.Spec.Ports[0].TargetPort, deployment)
 	containerPort := deployment.Spec.Template.Spec.Containers[0].Ports[0].ContainerPort
 	require.Equal(t, serviceTargetPort, containerPort, "Service target port should match deployment container port")
 }
 
+// AssertServiceTargetsNamedPort verifies that the container port is named and that the
+// Service targets it by that name.
+func AssertServiceTargetsNamedPort(t *testing.T, service *corev1.Service, deployment *appsv1.Deployment) {
+	t.Helper()
+	require.Len(t, service.Spec.Ports, 1, "Service should have exactly one port")
+	require.Len(t, deployment.Spec.Template.Spec.Containers[0].Ports, 1, "Container should have exactly one port")
+
+	containerPort := deployment.Spec.Template.Spec.Containers[0].Ports[0]
+	require.Equal(t, llamav1alpha1.DefaultContainerPortName, containerPort.Name, "Container port should be named")
+	require.Equal(t, intstr.FromString(containerPort.Name), service.Spec.Ports[0].TargetPort,
+		"Service target port should reference the container port name")
+}
+
 // AssertServiceSelectorMatches verifies that a service has the expected selector.
 func AssertServiceSelectorMatches(t *testing.T, service *corev1.Service, expectedSelector map[string]string) {
 	t.Helper()
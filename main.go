@@ -18,8 +18,10 @@ package main
 
 import (
 	"context"
+	"errors"
 	"flag"
 	"fmt"
+	"net/http"
 	"os"
 
 	llamaxk8siov1alpha1 "github.com/llamastack/llama-stack-k8s-operator/api/v1alpha1"
@@ -70,6 +72,7 @@ func setupReconciler(ctx context.Context, cli client.Client, mgr ctrl.Manager, c
 	if err != nil {
 		return fmt.Errorf("failed to create reconciler: %w", err)
 	}
+	reconciler.Recorder = mgr.GetEventRecorderFor("llamastackdistribution-controller")
 	if err = reconciler.SetupWithManager(ctx, mgr); err != nil {
 		return fmt.Errorf("failed to create controller: %w", err)
 	}
@@ -90,6 +93,11 @@ func newCacheOptions() cache.Options {
 					controllers.WatchLabelKey: controllers.WatchLabelValue,
 				}),
 			},
+			&corev1.Secret{}: {
+				Label: labels.SelectorFromSet(labels.Set{
+					controllers.WatchLabelKey: controllers.WatchLabelValue,
+				}),
+			},
 			&appsv1.Deployment{}:                     managedByFilter,
 			&policyv1.PodDisruptionBudget{}:          managedByFilter,
 			&autoscalingv2.HorizontalPodAutoscaler{}: managedByFilter,
@@ -101,25 +109,47 @@ func newCacheOptions() cache.Options {
 	}
 }
 
-func setupHealthChecks(mgr ctrl.Manager) error {
+func setupHealthChecks(mgr ctrl.Manager, leaderAwareReadiness bool) error {
 	if err := mgr.AddHealthzCheck("healthz", healthz.Ping); err != nil {
 		return fmt.Errorf("failed to set up health check: %w", err)
 	}
 	if err := mgr.AddReadyzCheck("readyz", healthz.Ping); err != nil {
 		return fmt.Errorf("failed to set up ready check: %w", err)
 	}
+	if leaderAwareReadiness {
+		if err := mgr.AddReadyzCheck("leader", leaderElectionReadyCheck(mgr.Elected())); err != nil {
+			return fmt.Errorf("failed to set up leader ready check: %w", err)
+		}
+	}
 	return nil
 }
 
+// leaderElectionReadyCheck reports ready only once the manager has been elected leader.
+// Without leader election the elected channel is closed immediately.
+func leaderElectionReadyCheck(elected <-chan struct{}) healthz.Checker {
+	return func(_ *http.Request) error {
+		select {
+		case <-elected:
+			return nil
+		default:
+			return errors.New("not the elected leader")
+		}
+	}
+}
+
 func main() {
 	var metricsAddr string
 	var enableLeaderElection bool
 	var probeAddr string
+	var leaderAwareReadiness bool
 	flag.StringVar(&metricsAddr, "metrics-bind-address", ":8080", "The address the metric endpoint binds to.")
 	flag.StringVar(&probeAddr, "health-probe-bind-address", ":8081", "The address the probe endpoint binds to.")
 	flag.BoolVar(&enableLeaderElection, "leader-elect", false,
 		"Enable leader election for controller manager. "+
 			"Enabling this will ensure there is only one active controller manager.")
+	flag.BoolVar(&leaderAwareReadiness, "leader-aware-readiness", false,
+		"Report ready only on the elected leader. "+
+			"Requires a rollout strategy that does not wait for the new replica to become ready.")
 	opts := zap.Options{
 		Development:     false,
 		StacktraceLevel: zapcore.PanicLevel, // Set higher than ErrorLevel to avoid stack traces in logs
@@ -190,7 +220,7 @@ func main() {
 		os.Exit(1)
 	}
 
-	if err := setupHealthChecks(mgr); err != nil {
+	if err := setupHealthChecks(mgr, leaderAwareReadiness); err != nil {
 		setupLog.Error(err, "failed to set up health checks")
 		os.Exit(1)
 	}
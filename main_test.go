@@ -0,0 +1,36 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package main
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestLeaderElectionReadyCheck(t *testing.T) {
+	elected := make(chan struct{})
+	check := leaderElectionReadyCheck(elected)
+	req := httptest.NewRequest(http.MethodGet, "/readyz", nil)
+
+	require.Error(t, check(req), "non-leader should not report ready")
+
+	close(elected)
+	require.NoError(t, check(req), "leader should report ready")
+}
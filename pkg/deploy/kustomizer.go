@@ -181,7 +181,7 @@ func patchResource(ctx context.Context, cli client.Client, desired, existing *un
 
 	switch existing.GetKind() {
 	case "PersistentVolumeClaim":
-		logger.V(1).Info("Skipping PVC patch - PVCs are immutable after creation",
+		logger.V(1).Info("Skipping PVC patch - PVC size is reconciled by the controller",
 			"name", existing.GetName(),
 			"namespace", existing.GetNamespace())
 		return nil
@@ -189,6 +189,13 @@ func patchResource(ctx context.Context, cli client.Client, desired, existing *un
 		if err := compare.CheckAndLogServiceChanges(ctx, cli, desired); err != nil {
 			return fmt.Errorf("failed to validate resource mutations while patching: %w", err)
 		}
+		if err := removeStaleSelectorLabels(ctx, cli, desired, existing, "spec", "selector"); err != nil {
+			return err
+		}
+	case "NetworkPolicy":
+		if err := removeStaleSelectorLabels(ctx, cli, desired, existing, "spec", "podSelector", "matchLabels"); err != nil {
+			return err
+		}
 	case deploymentKind:
 		// Some volume changes cannot be handled by SSA because the volumes were originally
 		// created via cli.Create (no SSA field manager tracking), so SSA cannot remove
@@ -217,6 +224,46 @@ func patchResource(ctx context.Context, cli client.Client, desired, existing *un
 	)
 }
 
+// removeStaleSelectorLabels deletes selector labels that the desired object no longer
+// sets. SSA cannot remove them when the object was originally created via cli.Create,
+// so a merge patch with null values removes them explicitly.
+func removeStaleSelectorLabels(ctx context.Context, cli client.Client, desired, existing *unstructured.Unstructured, path ...string) error {
+	existingLabels, _, err := unstructured.NestedMap(existing.Object, path...)
+	if err != nil {
+		return fmt.Errorf("failed to read existing %s selector: %w", existing.GetKind(), err)
+	}
+	desiredLabels, _, err := unstructured.NestedMap(desired.Object, path...)
+	if err != nil {
+		return fmt.Errorf("failed to read desired %s selector: %w", desired.GetKind(), err)
+	}
+
+	stale := map[string]any{}
+	for key := range existingLabels {
+		if _, ok := desiredLabels[key]; !ok {
+			stale[key] = nil
+		}
+	}
+	if len(stale) == 0 {
+		return nil
+	}
+
+	patch := map[string]any{}
+	if err := unstructured.SetNestedField(patch, stale, path...); err != nil {
+		return fmt.Errorf("failed to build %s selector patch: %w", existing.GetKind(), err)
+	}
+	data, err := json.Marshal(patch)
+	if err != nil {
+		return fmt.Errorf("failed to marshal %s selector patch: %w", existing.GetKind(), err)
+	}
+
+	log.FromContext(ctx).Info("Removing stale selector labels",
+		"kind", existing.GetKind(), "name", existing.GetName(), "namespace", existing.GetNamespace())
+	if err := cli.Patch(ctx, existing, client.RawPatch(k8stypes.MergePatchType, data)); err != nil {
+		return fmt.Errorf("failed to remove stale %s selector labels: %w", existing.GetKind(), err)
+	}
+	return nil
+}
+
 // applyPlugins runs all Go-based transformations on the resource map.
 func applyPlugins(resMap *resmap.ResMap, ownerInstance *llamav1alpha1.LlamaStackDistribution) error {
 	namePrefixPlugin := plugins.CreateNamePrefixPlugin(plugins.NamePrefixConfig{
@@ -254,6 +301,57 @@ func applyPlugins(resMap *resmap.ResMap, ownerInstance *llamav1alpha1.LlamaStack
 		}
 	}
 
+	if isCanaryEnabled(ownerInstance) {
+		if err := selectAllInstancePods(*resMap); err != nil {
+			return fmt.Errorf("failed to widen selectors for the canary: %w", err)
+		}
+	}
+
+	return nil
+}
+
+// isCanaryEnabled checks if the instance runs a canary Deployment next to the primary one.
+func isCanaryEnabled(instance *llamav1alpha1.LlamaStackDistribution) bool {
+	return instance != nil && instance.Spec.Rollout != nil && instance.Spec.Rollout.Canary != nil
+}
+
+// selectAllInstancePods drops the app label from the Service and NetworkPolicy pod
+// selectors so they match the canary pods, which carry their own app label to stay
+// out of the immutable primary Deployment selector.
+func selectAllInstancePods(resMap resmap.ResMap) error {
+	for _, res := range resMap.Resources() {
+		var path []string
+		switch res.GetKind() {
+		case "Service":
+			path = []string{"spec", "selector"}
+		case "NetworkPolicy":
+			path = []string{"spec", "podSelector", "matchLabels"}
+		default:
+			continue
+		}
+
+		data, err := parseResourceYAML(res)
+		if err != nil {
+			return err
+		}
+
+		selector, found, err := unstructured.NestedMap(data, path...)
+		if err != nil {
+			return fmt.Errorf("failed to read %s selector: %w", res.GetKind(), err)
+		}
+		if !found {
+			continue
+		}
+		delete(selector, llamav1alpha1.DefaultLabelKey)
+		if err := unstructured.SetNestedMap(data, selector, path...); err != nil {
+			return fmt.Errorf("failed to update %s selector: %w", res.GetKind(), err)
+		}
+
+		if err := updateResourceFromData(res, data); err != nil {
+			return err
+		}
+	}
+
 	return nil
 }
 
@@ -317,14 +415,53 @@ func getFieldMappings(ownerInstance *llamav1alpha1.LlamaStackDistribution) []plu
 
 	mappings := buildFieldMappings(instanceName, instanceNamespace, serviceAccountName, servicePort, storageSize, instanceLabelPath, ownerInstance.Spec.Replicas)
 
-	// When persistent storage is configured, use Recreate strategy to avoid
-	// RWO PVC multi-attach deadlock during rolling updates
-	if ownerInstance.Spec.Server.Storage != nil {
-		mappings = append(mappings, plugins.FieldMapping{
-			SourceValue:       "Recreate",
+	mappings = append(mappings, getStrategyFieldMappings(ownerInstance)...)
+	mappings = append(mappings, getSessionAffinityFieldMappings(ownerInstance)...)
+	mappings = append(mappings, getPublishNotReadyAddressesFieldMappings(ownerInstance)...)
+
+	return mappings
+}
+
+// getStrategyFieldMappings returns the Deployment strategy mappings. An explicit
+// updateStrategy wins; otherwise, when persistent storage is configured, use
+// Recreate strategy to avoid RWO PVC multi-attach deadlock during rolling updates.
+func getStrategyFieldMappings(ownerInstance *llamav1alpha1.LlamaStackDistribution) []plugins.FieldMapping {
+	strategy := ownerInstance.Spec.Server.UpdateStrategy
+	if strategy == nil {
+		if !ownerInstance.HasPersistentStorage() {
+			return nil
+		}
+		strategy = &llamav1alpha1.UpdateStrategySpec{Type: string(appsv1.RecreateDeploymentStrategyType)}
+	}
+
+	mappings := []plugins.FieldMapping{
+		{
+			SourceValue:       strategy.Type,
 			TargetField:       "/spec/strategy/type",
 			TargetKind:        "Deployment",
 			CreateIfNotExists: true,
+		},
+	}
+
+	if strategy.Type != string(appsv1.RollingUpdateDeploymentStrategyType) {
+		return mappings
+	}
+
+	if strategy.MaxSurge != nil {
+		mappings = append(mappings, plugins.FieldMapping{
+			SourceValue:       intOrStringToInterface(strategy.MaxSurge),
+			TargetField:       "/spec/strategy/rollingUpdate/maxSurge",
+			TargetKind:        "Deployment",
+			CreateIfNotExists: true,
+		})
+	}
+
+	if strategy.MaxUnavailable != nil {
+		mappings = append(mappings, plugins.FieldMapping{
+			SourceValue:       intOrStringToInterface(strategy.MaxUnavailable),
+			TargetField:       "/spec/strategy/rollingUpdate/maxUnavailable",
+			TargetKind:        "Deployment",
+			CreateIfNotExists: true,
 		})
 	}
 
@@ -351,8 +488,7 @@ func buildFieldMappings(instanceName, instanceNamespace, serviceAccountName stri
 			CreateIfNotExists: true,
 		},
 		{
-			SourceValue:       servicePort,
-			DefaultValue:      llamav1alpha1.DefaultServerPort,
+			SourceValue:       llamav1alpha1.DefaultContainerPortName,
 			TargetField:       "/spec/ports/0/targetPort",
 			TargetKind:        "Service",
 			CreateIfNotExists: true,
@@ -363,6 +499,12 @@ func buildFieldMappings(instanceName, instanceNamespace, serviceAccountName stri
 			TargetKind:        "Service",
 			CreateIfNotExists: true,
 		},
+		{
+			SourceValue:       instanceName,
+			TargetField:       "/metadata/labels" + instanceLabelPath,
+			TargetKind:        "Service",
+			CreateIfNotExists: true,
+		},
 		{
 			SourceValue:       instanceName,
 			TargetField:       "/metadata/name",
@@ -420,6 +562,56 @@ func buildFieldMappings(instanceName, instanceNamespace, serviceAccountName stri
 	}
 }
 
+// getSessionAffinityFieldMappings returns the Service session affinity mappings.
+// None is the Kubernetes default, so only ClientIP needs to be rendered.
+func getSessionAffinityFieldMappings(ownerInstance *llamav1alpha1.LlamaStackDistribution) []plugins.FieldMapping {
+	if ownerInstance.Spec.Network == nil || ownerInstance.Spec.Network.SessionAffinity == nil {
+		return nil
+	}
+
+	affinity := ownerInstance.Spec.Network.SessionAffinity
+	if affinity.Type != string(corev1.ServiceAffinityClientIP) {
+		return nil
+	}
+
+	mappings := []plugins.FieldMapping{
+		{
+			SourceValue:       affinity.Type,
+			TargetField:       "/spec/sessionAffinity",
+			TargetKind:        "Service",
+			CreateIfNotExists: true,
+		},
+	}
+
+	if affinity.TimeoutSeconds != nil {
+		mappings = append(mappings, plugins.FieldMapping{
+			SourceValue:       *affinity.TimeoutSeconds,
+			TargetField:       "/spec/sessionAffinityConfig/clientIP/timeoutSeconds",
+			TargetKind:        "Service",
+			CreateIfNotExists: true,
+		})
+	}
+
+	return mappings
+}
+
+// getPublishNotReadyAddressesFieldMappings returns the Service publishNotReadyAddresses mapping.
+// False is the Kubernetes default, so it is only rendered when enabled.
+func getPublishNotReadyAddressesFieldMappings(ownerInstance *llamav1alpha1.LlamaStackDistribution) []plugins.FieldMapping {
+	if ownerInstance.Spec.Network == nil || !ownerInstance.Spec.Network.PublishNotReadyAddresses {
+		return nil
+	}
+
+	return []plugins.FieldMapping{
+		{
+			SourceValue:       true,
+			TargetField:       "/spec/publishNotReadyAddresses",
+			TargetKind:        "Service",
+			CreateIfNotExists: true,
+		},
+	}
+}
+
 // getStorageSize extracts the storage size from the CR spec.
 func getStorageSize(instance *llamav1alpha1.LlamaStackDistribution) string {
 	if instance.Spec.Server.Storage != nil && instance.Spec.Server.Storage.Size != nil {
@@ -451,6 +643,8 @@ type ManifestContext struct {
 	ResolvedImage           string
 	ConfigMapHash           string
 	CABundleHash            string
+	SecretHash              string
+	RollToken               string
 	ContainerSpec           map[string]any
 	PodSpec                 map[string]any
 	PodDisruptionBudgetSpec *policyv1.PodDisruptionBudgetSpec
@@ -568,7 +762,7 @@ func getDeploymentTemplateSpec(data map[string]any) (map[string]any, error) {
 	return templateSpec, nil
 }
 
-// addConfigMapAnnotations adds ConfigMap hash annotations to the deployment template.
+// addConfigMapAnnotations adds ConfigMap hash and roll annotations to the deployment template.
 func addConfigMapAnnotations(data map[string]any, manifestCtx *ManifestContext) error {
 	spec, ok := data["spec"].(map[string]any)
 	if !ok {
@@ -598,6 +792,12 @@ func addConfigMapAnnotations(data map[string]any, manifestCtx *ManifestContext)
 	if manifestCtx.CABundleHash != "" {
 		annotations["configmap.hash/ca-bundle"] = manifestCtx.CABundleHash
 	}
+	if manifestCtx.SecretHash != "" {
+		annotations["secret.hash/env"] = manifestCtx.SecretHash
+	}
+	if manifestCtx.RollToken != "" {
+		annotations[llamav1alpha1.RollAnnotationKey] = manifestCtx.RollToken
+	}
 
 	return nil
 }
@@ -21,6 +21,7 @@ import (
 	"k8s.io/apimachinery/pkg/util/yaml"
 	"k8s.io/client-go/kubernetes/scheme"
 	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
 	"sigs.k8s.io/kustomize/api/resmap"
 	kresource "sigs.k8s.io/kustomize/api/resource"
 	"sigs.k8s.io/kustomize/kyaml/filesys"
@@ -631,6 +632,55 @@ func TestRemoveDeploymentReplicas(t *testing.T) {
 	require.False(t, hasReplicas, "replicas should be removed from all deployments when autoscaling is enabled")
 }
 
+func TestSelectAllInstancePods(t *testing.T) {
+	t.Parallel()
+
+	resMap := resmap.New()
+	require.NoError(t, resMap.Append(newTestResource(t, "v1", "Service", "service", "llama", map[string]any{
+		"selector": map[string]any{
+			"app":                        "llama-stack",
+			"app.kubernetes.io/instance": "example",
+		},
+	})))
+
+	require.NoError(t, selectAllInstancePods(resMap))
+
+	data, err := resMap.Resources()[0].Map()
+	require.NoError(t, err)
+	selector, found, err := unstructured.NestedStringMap(data, "spec", "selector")
+	require.NoError(t, err)
+	require.True(t, found)
+	require.Equal(t, map[string]string{"app.kubernetes.io/instance": "example"}, selector,
+		"the Service should select the canary pods through the instance label")
+}
+
+func TestRemoveStaleSelectorLabels(t *testing.T) {
+	t.Parallel()
+
+	service := &corev1.Service{
+		ObjectMeta: metav1.ObjectMeta{Name: "example-service", Namespace: "llama"},
+		Spec: corev1.ServiceSpec{
+			Selector: map[string]string{
+				"app":                        "llama-stack",
+				"app.kubernetes.io/instance": "example",
+			},
+		},
+	}
+	cli := fake.NewClientBuilder().WithScheme(scheme.Scheme).WithObjects(service).Build()
+
+	existing := &unstructured.Unstructured{}
+	existing.SetGroupVersionKind(corev1.SchemeGroupVersion.WithKind("Service"))
+	require.NoError(t, cli.Get(t.Context(), client.ObjectKeyFromObject(service), existing))
+	desired := existing.DeepCopy()
+	unstructured.RemoveNestedField(desired.Object, "spec", "selector", "app")
+
+	require.NoError(t, removeStaleSelectorLabels(t.Context(), cli, desired, existing, "spec", "selector"))
+
+	updated := &corev1.Service{}
+	require.NoError(t, cli.Get(t.Context(), client.ObjectKeyFromObject(service), updated))
+	require.Equal(t, map[string]string{"app.kubernetes.io/instance": "example"}, updated.Spec.Selector)
+}
+
 // TestHasLegacyCABundleVolumes tests the detection of legacy CA bundle volumes.
 func TestHasLegacyCABundleVolumes(t *testing.T) {
 	ctx := t.Context()
@@ -1099,6 +1149,229 @@ func TestGetFieldMappings_RecreateStrategyWithStorage(t *testing.T) {
 	})
 }
 
+func TestGetFieldMappings_UpdateStrategy(t *testing.T) {
+	findMapping := func(mappings []plugins.FieldMapping, field string) *plugins.FieldMapping {
+		for i := range mappings {
+			if mappings[i].TargetField == field && mappings[i].TargetKind == "Deployment" {
+				return &mappings[i]
+			}
+		}
+		return nil
+	}
+
+	t.Run("explicit Recreate strategy is applied", func(t *testing.T) {
+		owner := &llamav1alpha1.LlamaStackDistribution{
+			ObjectMeta: metav1.ObjectMeta{Name: "test", Namespace: "default"},
+			Spec: llamav1alpha1.LlamaStackDistributionSpec{
+				Replicas: 1,
+				Server: llamav1alpha1.ServerSpec{
+					UpdateStrategy: &llamav1alpha1.UpdateStrategySpec{Type: "Recreate"},
+				},
+			},
+		}
+
+		mappings := getFieldMappings(owner)
+
+		strategyType := findMapping(mappings, "/spec/strategy/type")
+		require.NotNil(t, strategyType)
+		assert.Equal(t, "Recreate", strategyType.SourceValue)
+		assert.Nil(t, findMapping(mappings, "/spec/strategy/rollingUpdate/maxSurge"))
+		assert.Nil(t, findMapping(mappings, "/spec/strategy/rollingUpdate/maxUnavailable"))
+	})
+
+	t.Run("disabled storage keeps the default strategy", func(t *testing.T) {
+		owner := &llamav1alpha1.LlamaStackDistribution{
+			ObjectMeta: metav1.ObjectMeta{Name: "test", Namespace: "default"},
+			Spec: llamav1alpha1.LlamaStackDistributionSpec{
+				Replicas: 1,
+				Server: llamav1alpha1.ServerSpec{
+					Storage: &llamav1alpha1.StorageSpec{Disabled: true},
+				},
+			},
+		}
+
+		mappings := getFieldMappings(owner)
+
+		assert.Nil(t, findMapping(mappings, "/spec/strategy/type"))
+	})
+
+	t.Run("explicit RollingUpdate overrides storage default and sets surge values", func(t *testing.T) {
+		owner := &llamav1alpha1.LlamaStackDistribution{
+			ObjectMeta: metav1.ObjectMeta{Name: "test", Namespace: "default"},
+			Spec: llamav1alpha1.LlamaStackDistributionSpec{
+				Replicas: 1,
+				Server: llamav1alpha1.ServerSpec{
+					Storage: &llamav1alpha1.StorageSpec{},
+					UpdateStrategy: &llamav1alpha1.UpdateStrategySpec{
+						Type:           "RollingUpdate",
+						MaxSurge:       ptr(intstr.FromInt(0)),
+						MaxUnavailable: ptr(intstr.FromString("50%")),
+					},
+				},
+			},
+		}
+
+		mappings := getFieldMappings(owner)
+
+		strategyType := findMapping(mappings, "/spec/strategy/type")
+		require.NotNil(t, strategyType)
+		assert.Equal(t, "RollingUpdate", strategyType.SourceValue)
+
+		maxSurge := findMapping(mappings, "/spec/strategy/rollingUpdate/maxSurge")
+		require.NotNil(t, maxSurge)
+		assert.Equal(t, 0, maxSurge.SourceValue)
+
+		maxUnavailable := findMapping(mappings, "/spec/strategy/rollingUpdate/maxUnavailable")
+		require.NotNil(t, maxUnavailable)
+		assert.Equal(t, "50%", maxUnavailable.SourceValue)
+	})
+
+	t.Run("rendered deployment carries the custom rolling update values", func(t *testing.T) {
+		fsys := filesys.MakeFsInMemory()
+		require.NoError(t, fsys.MkdirAll(manifestBasePath))
+		require.NoError(t, fsys.WriteFile(filepath.Join(manifestBasePath, "kustomization.yaml"), []byte(`
+apiVersion: kustomize.config.k8s.io/v1beta1
+kind: Kustomization
+resources:
+  - deployment.yaml
+`)))
+		require.NoError(t, fsys.WriteFile(filepath.Join(manifestBasePath, "deployment.yaml"), []byte(`
+apiVersion: apps/v1
+kind: Deployment
+metadata:
+  name: deployment`)))
+
+		owner := &llamav1alpha1.LlamaStackDistribution{
+			ObjectMeta: metav1.ObjectMeta{Name: "test-instance", Namespace: "test-strategy-ns"},
+			Spec: llamav1alpha1.LlamaStackDistributionSpec{
+				Replicas: 1,
+				Server: llamav1alpha1.ServerSpec{
+					UpdateStrategy: &llamav1alpha1.UpdateStrategySpec{
+						Type:           "RollingUpdate",
+						MaxSurge:       ptr(intstr.FromInt(1)),
+						MaxUnavailable: ptr(intstr.FromInt(0)),
+					},
+				},
+			},
+		}
+
+		resMap, err := RenderManifest(fsys, manifestBasePath, owner)
+		require.NoError(t, err)
+		require.Equal(t, 1, (*resMap).Size())
+
+		finalMap, err := (*resMap).Resources()[0].Map()
+		require.NoError(t, err)
+		strategyType, _, err := unstructured.NestedString(finalMap, "spec", "strategy", "type")
+		require.NoError(t, err)
+		assert.Equal(t, "RollingUpdate", strategyType)
+		maxSurge, _, err := unstructured.NestedFieldNoCopy(finalMap, "spec", "strategy", "rollingUpdate", "maxSurge")
+		require.NoError(t, err)
+		assert.EqualValues(t, 1, maxSurge)
+		maxUnavailable, _, err := unstructured.NestedFieldNoCopy(finalMap, "spec", "strategy", "rollingUpdate", "maxUnavailable")
+		require.NoError(t, err)
+		assert.EqualValues(t, 0, maxUnavailable)
+	})
+}
+
+func TestGetFieldMappings_SessionAffinity(t *testing.T) {
+	renderService := func(t *testing.T, network *llamav1alpha1.NetworkSpec) map[string]any {
+		t.Helper()
+
+		fsys := filesys.MakeFsInMemory()
+		require.NoError(t, fsys.MkdirAll(manifestBasePath))
+		require.NoError(t, fsys.WriteFile(filepath.Join(manifestBasePath, "kustomization.yaml"), []byte(`
+apiVersion: kustomize.config.k8s.io/v1beta1
+kind: Kustomization
+resources:
+  - service.yaml
+`)))
+		require.NoError(t, fsys.WriteFile(filepath.Join(manifestBasePath, "service.yaml"), []byte(`
+apiVersion: v1
+kind: Service
+metadata:
+  name: service
+spec:
+  type: ClusterIP
+  ports:
+  - name: http
+    protocol: TCP`)))
+
+		owner := &llamav1alpha1.LlamaStackDistribution{
+			ObjectMeta: metav1.ObjectMeta{Name: "test-instance", Namespace: "test-affinity-ns"},
+			Spec: llamav1alpha1.LlamaStackDistributionSpec{
+				Replicas: 2,
+				Network:  network,
+			},
+		}
+
+		resMap, err := RenderManifest(fsys, manifestBasePath, owner)
+		require.NoError(t, err)
+		require.Equal(t, 1, (*resMap).Size())
+
+		finalMap, err := (*resMap).Resources()[0].Map()
+		require.NoError(t, err)
+		return finalMap
+	}
+
+	t.Run("ClientIP affinity with timeout is applied", func(t *testing.T) {
+		service := renderService(t, &llamav1alpha1.NetworkSpec{
+			SessionAffinity: &llamav1alpha1.SessionAffinitySpec{
+				Type:           "ClientIP",
+				TimeoutSeconds: ptr(int32(600)),
+			},
+		})
+
+		affinity, found, err := unstructured.NestedString(service, "spec", "sessionAffinity")
+		require.NoError(t, err)
+		require.True(t, found)
+		assert.Equal(t, "ClientIP", affinity)
+
+		timeout, found, err := unstructured.NestedFieldNoCopy(service, "spec", "sessionAffinityConfig", "clientIP", "timeoutSeconds")
+		require.NoError(t, err)
+		require.True(t, found)
+		assert.EqualValues(t, 600, timeout)
+	})
+
+	t.Run("None leaves session affinity unset", func(t *testing.T) {
+		service := renderService(t, &llamav1alpha1.NetworkSpec{
+			SessionAffinity: &llamav1alpha1.SessionAffinitySpec{Type: "None"},
+		})
+
+		_, found, err := unstructured.NestedFieldNoCopy(service, "spec", "sessionAffinity")
+		require.NoError(t, err)
+		assert.False(t, found)
+		_, found, err = unstructured.NestedFieldNoCopy(service, "spec", "sessionAffinityConfig")
+		require.NoError(t, err)
+		assert.False(t, found)
+	})
+
+	t.Run("publishNotReadyAddresses is applied when enabled", func(t *testing.T) {
+		service := renderService(t, &llamav1alpha1.NetworkSpec{PublishNotReadyAddresses: true})
+
+		publish, found, err := unstructured.NestedBool(service, "spec", "publishNotReadyAddresses")
+		require.NoError(t, err)
+		require.True(t, found)
+		assert.True(t, publish)
+	})
+
+	t.Run("publishNotReadyAddresses is left unset by default", func(t *testing.T) {
+		service := renderService(t, &llamav1alpha1.NetworkSpec{})
+
+		_, found, err := unstructured.NestedFieldNoCopy(service, "spec", "publishNotReadyAddresses")
+		require.NoError(t, err)
+		assert.False(t, found)
+	})
+
+	t.Run("Service is labeled with the instance for ServiceMonitor selection", func(t *testing.T) {
+		service := renderService(t, nil)
+
+		instance, found, err := unstructured.NestedString(service, "metadata", "labels", "app.kubernetes.io/instance")
+		require.NoError(t, err)
+		require.True(t, found)
+		assert.Equal(t, "test-instance", instance)
+	})
+}
+
 // resourceToUnstructured converts a kustomize resource to an unstructured object.
 func resourceToUnstructured(t *testing.T, res *kresource.Resource) (*unstructured.Unstructured, error) {
 	t.Helper()
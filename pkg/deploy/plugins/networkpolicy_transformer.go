@@ -21,6 +21,7 @@ import (
 	"fmt"
 
 	llamav1alpha1 "github.com/llamastack/llama-stack-k8s-operator/api/v1alpha1"
+	"k8s.io/apimachinery/pkg/runtime"
 	"sigs.k8s.io/kustomize/api/resmap"
 	"sigs.k8s.io/kustomize/api/resource"
 	"sigs.k8s.io/yaml"
@@ -92,7 +93,10 @@ func (t *networkPolicyTransformer) transformNetworkPolicy(res *resource.Resource
 	}
 
 	// Build and set ingress rules
-	ingressRules := t.buildIngressRules()
+	ingressRules, err := t.buildIngressRules()
+	if err != nil {
+		return err
+	}
 	spec["ingress"] = ingressRules
 
 	return updateResource(res, data)
@@ -117,8 +121,11 @@ func (t *networkPolicyTransformer) updatePodSelector(spec map[string]any) error
 	return nil
 }
 
-func (t *networkPolicyTransformer) buildIngressRules() []any {
-	peers := t.buildPeers()
+func (t *networkPolicyTransformer) buildIngressRules() ([]any, error) {
+	peers, err := t.buildPeers()
+	if err != nil {
+		return nil, err
+	}
 
 	portRule := []any{
 		map[string]any{
@@ -132,25 +139,31 @@ func (t *networkPolicyTransformer) buildIngressRules() []any {
 			"from":  peers,
 			"ports": portRule,
 		},
-	}
+	}, nil
 }
 
-func (t *networkPolicyTransformer) buildPeers() []any {
+func (t *networkPolicyTransformer) buildPeers() ([]any, error) {
 	// Check if all namespaces are allowed
 	if t.isAllNamespacesAllowed() {
 		return []any{
 			map[string]any{
 				"namespaceSelector": map[string]any{}, // Empty selector matches all
 			},
-		}
+		}, nil
+	}
+
+	podPeers, err := t.buildPodSelectorPeers()
+	if err != nil {
+		return nil, err
 	}
 
 	peers := t.buildDefaultPeers()
 	peers = append(peers, t.buildNamespacePeers()...)
 	peers = append(peers, t.buildLabelPeers()...)
+	peers = append(peers, podPeers...)
 	peers = append(peers, t.buildRouterPeers()...)
 
-	return peers
+	return peers, nil
 }
 
 func (t *networkPolicyTransformer) isAllNamespacesAllowed() bool {
@@ -236,6 +249,27 @@ func (t *networkPolicyTransformer) buildLabelPeers() []any {
 	return peers
 }
 
+// buildPodSelectorPeers builds a NetworkPolicy peer for pods matching the selector in any namespace.
+func (t *networkPolicyTransformer) buildPodSelectorPeers() ([]any, error) {
+	if t.config.NetworkSpec == nil || t.config.NetworkSpec.AllowedFrom == nil ||
+		t.config.NetworkSpec.AllowedFrom.PodSelector == nil {
+		return nil, nil
+	}
+
+	podSelector, err := runtime.DefaultUnstructuredConverter.ToUnstructured(t.config.NetworkSpec.AllowedFrom.PodSelector)
+	if err != nil {
+		return nil, fmt.Errorf("failed to convert pod selector: %w", err)
+	}
+
+	// The empty namespaceSelector extends the podSelector to all namespaces.
+	return []any{
+		map[string]any{
+			"namespaceSelector": map[string]any{},
+			"podSelector":       podSelector,
+		},
+	}, nil
+}
+
 // buildRouterPeers builds NetworkPolicy peers for ingress controller traffic.
 func (t *networkPolicyTransformer) buildRouterPeers() []any {
 	if t.config.NetworkSpec == nil {
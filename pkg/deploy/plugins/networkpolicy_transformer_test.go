@@ -22,6 +22,7 @@ import (
 	llamav1alpha1 "github.com/llamastack/llama-stack-k8s-operator/api/v1alpha1"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"sigs.k8s.io/kustomize/api/resmap"
 	"sigs.k8s.io/kustomize/api/resource"
 )
@@ -181,6 +182,43 @@ func TestNetworkPolicyTransformer_LabelSelectors(t *testing.T) {
 	assert.Contains(t, yamlStr, "operator: Exists")
 }
 
+func TestNetworkPolicyTransformer_PodSelector(t *testing.T) {
+	rf := resource.NewFactory(nil)
+	res, err := rf.FromBytes([]byte(networkPolicyTestYAML))
+	require.NoError(t, err)
+
+	rm := resmap.New()
+	require.NoError(t, rm.Append(res))
+
+	transformer := CreateNetworkPolicyTransformer(NetworkPolicyTransformerConfig{
+		InstanceName:      "test-instance",
+		ServicePort:       8321,
+		OperatorNamespace: "operator-ns",
+		NetworkSpec: &llamav1alpha1.NetworkSpec{
+			AllowedFrom: &llamav1alpha1.AllowedFromSpec{
+				Namespaces: []string{"allowed-ns"},
+				PodSelector: &metav1.LabelSelector{
+					MatchLabels: map[string]string{"role": "gateway"},
+				},
+			},
+		},
+	})
+
+	err = transformer.Transform(rm)
+	require.NoError(t, err)
+
+	transformedRes := rm.Resources()[0]
+	yamlBytes, err := transformedRes.AsYAML()
+	require.NoError(t, err)
+
+	yamlStr := string(yamlBytes)
+
+	// Pod selector peer is added alongside the namespace peers
+	assert.Contains(t, yamlStr, "kubernetes.io/metadata.name: allowed-ns")
+	assert.Contains(t, yamlStr, "role: gateway")
+	assert.Contains(t, yamlStr, "namespaceSelector: {}")
+}
+
 func TestNetworkPolicyTransformer_CustomPort(t *testing.T) {
 	rf := resource.NewFactory(nil)
 	res, err := rf.FromBytes([]byte(networkPolicyTestYAML))